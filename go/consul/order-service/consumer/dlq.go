@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/streadway/amqp"
+)
+
+// runDLQ lists the dead-letter queue without removing anything:
+//
+//	consumer dlq [-n 20]
+func runDLQ(ch *amqp.Channel, args []string) error {
+	flags := flag.NewFlagSet("dlq", flag.ExitOnError)
+	limit := flags.Int("n", 20, "maximum number of messages to show")
+	flags.Parse(args)
+
+	// Messages stay unacked until the channel closes, which puts them back
+	// on the queue in their original order.
+	count := 0
+	for count < *limit {
+		d, ok, err := ch.Get(deadLetterQueue, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		count++
+		fmt.Printf("message %s (%s), %d attempts, last error: %v\n  %s\n",
+			d.MessageId, originalRoutingKey(d), deliveryAttempts(d), d.Headers[lastErrorHeader], d.Body)
+	}
+	fmt.Printf("%d messages shown\n", count)
+	return nil
+}
+
+// runReplay moves dead-lettered messages back onto the main queue with a
+// fresh set of attempts:
+//
+//	consumer replay [-n 10]
+func runReplay(ch *amqp.Channel, args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	limit := flags.Int("n", 0, "maximum number of messages to replay (0 replays all)")
+	flags.Parse(args)
+
+	// Only replay what's there now, so messages that fail again while
+	// replaying aren't picked up twice
+	q, err := ch.QueueInspect(deadLetterQueue)
+	if err != nil {
+		return err
+	}
+	if *limit == 0 || *limit > q.Messages {
+		*limit = q.Messages
+	}
+
+	r, err := newRetrier(ch, 0)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for count < *limit {
+		d, ok, err := ch.Get(deadLetterQueue, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		headers := amqp.Table{}
+		for k, v := range d.Headers {
+			headers[k] = v
+		}
+		delete(headers, attemptsHeader)
+		delete(headers, lastErrorHeader)
+
+		// Publish straight to our queue so other subscribers of the
+		// orders exchange don't see the event twice
+		if err := r.publish("", queueName, d, headers); err != nil {
+			d.Nack(false, true)
+			return fmt.Errorf("replay message %s: %w", d.MessageId, err)
+		}
+		d.Ack(false)
+		count++
+	}
+	log.Printf("Replayed %d messages", count)
+	return nil
+}
@@ -1,14 +1,26 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"shared/idempotency"
 
 	"github.com/streadway/amqp"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
+const consumerTag = "order-consumer"
+
 type Order struct {
 	ID        uint    `json:"id"`
 	UserID    uint    `json:"user_id"`
@@ -22,6 +34,8 @@ func main() {
 	rabbitHost := getEnv("RABBITMQ_HOST", "localhost")
 	rabbitPort := getEnv("RABBITMQ_PORT", "5672")
 	rabbitURL := fmt.Sprintf("amqp://guest:guest@%s:%s/", rabbitHost, rabbitPort)
+	maxAttempts := getIntEnv("MAX_ATTEMPTS", 4)
+	retention := getDurationEnv("DEDUP_RETENTION", 7*24*time.Hour)
 
 	// Connect to RabbitMQ
 	conn, err := amqp.Dial(rabbitURL)
@@ -36,50 +50,65 @@ func main() {
 	}
 	defer ch.Close()
 
-	// Declare exchange
-	err = ch.ExchangeDeclare(
-		"orders",
-		"topic",
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
+	// Declare exchanges and queues, including retry and dead-letter queues
+	if err := declareTopology(ch); err != nil {
+		log.Fatal("Failed to declare topology:", err)
+	}
+
+	// Dead-letter queue commands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "dlq":
+			err = runDLQ(ch, os.Args[2:])
+		case "replay":
+			err = runReplay(ch, os.Args[2:])
+		default:
+			err = fmt.Errorf("unknown command %q, expected dlq or replay", os.Args[1])
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// Remember processed message IDs in the order database
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		getEnv("DB_HOST", "localhost"), getEnv("DB_USER", "postgres"), getEnv("DB_PASSWORD", "postgres123"),
+		getEnv("DB_NAME", "order_db"), getEnv("DB_PORT", "5432"))
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
 	if err != nil {
-		log.Fatal("Failed to declare exchange:", err)
+		log.Fatal("Failed to connect to database:", err)
 	}
+	processed, err := idempotency.NewStore(db, queueName, retention)
+	if err != nil {
+		log.Fatal("Failed to create processed message store:", err)
+	}
+	ctx, stopPruning := context.WithCancel(context.Background())
+	defer stopPruning()
+	go processed.Run(ctx)
 
-	// Declare queue
-	q, err := ch.QueueDeclare(
-		"order_notifications",
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
+	// Failed messages are republished on their own channel
+	pub, err := conn.Channel()
 	if err != nil {
-		log.Fatal("Failed to declare queue:", err)
+		log.Fatal("Failed to open a channel:", err)
 	}
+	defer pub.Close()
 
-	// Bind queue to exchange
-	err = ch.QueueBind(
-		q.Name,
-		"order.*",
-		"orders",
-		false,
-		nil,
-	)
+	retries, err := newRetrier(pub, maxAttempts)
 	if err != nil {
-		log.Fatal("Failed to bind queue:", err)
+		log.Fatal("Failed to enable publisher confirms:", err)
+	}
+
+	// Only hold a few unacked messages at a time
+	if err := ch.Qos(10, 0, false); err != nil {
+		log.Fatal("Failed to set QoS:", err)
 	}
 
-	// Consume messages
+	// Consume messages, acking them once they're handled
 	msgs, err := ch.Consume(
-		q.Name,
-		"",
-		true,
+		queueName,
+		consumerTag,
+		false,
 		false,
 		false,
 		false,
@@ -91,24 +120,58 @@ func main() {
 
 	log.Println("Waiting for messages...")
 
-	forever := make(chan bool)
-
+	done := make(chan struct{})
 	go func() {
 		for d := range msgs {
-			var order Order
-			if err := json.Unmarshal(d.Body, &order); err != nil {
-				log.Printf("Error parsing message: %v", err)
-				continue
-			}
-
-			log.Printf("Received order: ID=%d, UserID=%d, Amount=%.2f, Status=%s",
-				order.ID, order.UserID, order.Amount, order.Status)
-
-			// Process the order (send email, update inventory, etc.)
+			retries.handle(d, func(d amqp.Delivery) error {
+				return processOrder(processed, d)
+			})
 		}
+		close(done)
 	}()
 
-	<-forever
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-quit:
+		// Stop new deliveries and finish the one in progress
+		ch.Cancel(consumerTag, false)
+		<-done
+	case <-done:
+		log.Fatal("RabbitMQ closed the delivery channel")
+	}
+}
+
+// processOrder handles an order event once, skipping redeliveries of
+// messages that were already processed.
+func processOrder(processed *idempotency.Store, d amqp.Delivery) error {
+	var order Order
+	if err := json.Unmarshal(d.Body, &order); err != nil {
+		return permanent(fmt.Errorf("parse message: %w", err))
+	}
+	event := originalRoutingKey(d)
+
+	duplicate, err := processed.Process(d.MessageId, func(tx *gorm.DB) error {
+		return handleOrder(event, order)
+	})
+	if errors.Is(err, idempotency.ErrMissingID) {
+		// Published before events had IDs, so it can't be deduplicated
+		log.Printf("Message without an ID, processing %s for order %d anyway", event, order.ID)
+		return handleOrder(event, order)
+	}
+	if duplicate {
+		log.Printf("Skipping duplicate message %s (%s for order %d)", d.MessageId, event, order.ID)
+	}
+	return err
+}
+
+func handleOrder(event string, order Order) error {
+	log.Printf("Received %s: ID=%d, UserID=%d, Amount=%.2f, Status=%s",
+		event, order.ID, order.UserID, order.Amount, order.Status)
+
+	// Process the order (send email, update inventory, etc.)
+	return nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -118,3 +181,19 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// Headers the consumer keeps on messages it retries or dead-letters.
+const (
+	attemptsHeader   = "x-attempts"
+	lastErrorHeader  = "x-last-error"
+	routingKeyHeader = "x-original-routing-key"
+)
+
+const confirmTimeout = 5 * time.Second
+
+// permanentError marks a failure retrying can't fix, like a malformed
+// message, so it goes straight to the dead-letter queue.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// retrier acknowledges deliveries once they're handled, moving failed ones
+// to a retry queue or the dead-letter exchange.
+type retrier struct {
+	pub         *amqp.Channel
+	confirms    chan amqp.Confirmation
+	published   uint64 // Delivery tag of the last publish on pub
+	maxAttempts int
+}
+
+// newRetrier puts pub in confirm mode, so a failed message is only acked
+// once its copy is safely on the retry or dead-letter queue.
+func newRetrier(pub *amqp.Channel, maxAttempts int) (*retrier, error) {
+	if err := pub.Confirm(false); err != nil {
+		return nil, err
+	}
+	return &retrier{
+		pub:         pub,
+		confirms:    pub.NotifyPublish(make(chan amqp.Confirmation, 1)),
+		maxAttempts: maxAttempts,
+	}, nil
+}
+
+// handle runs fn and acks d. When fn fails, d is republished to the next
+// retry queue, or to the dead-letter exchange after maxAttempts or a
+// permanent error. If that publish fails d is requeued instead.
+func (r *retrier) handle(d amqp.Delivery, fn func(amqp.Delivery) error) {
+	err := fn(d)
+	if err == nil {
+		d.Ack(false)
+		return
+	}
+
+	attempts := deliveryAttempts(d) + 1
+	exchange, key := retryExchange, retryQueue(retryLevel(attempts))
+	var perm *permanentError
+	if errors.As(err, &perm) || attempts >= r.maxAttempts {
+		exchange, key = deadLetterExchange, ""
+		log.Printf("Dead-lettering message %s after %d attempts: %v", d.MessageId, attempts, err)
+	} else {
+		log.Printf("Retrying message %s in %v (attempt %d of %d): %v",
+			d.MessageId, retryDelays[retryLevel(attempts)], attempts, r.maxAttempts, err)
+	}
+
+	headers := amqp.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[attemptsHeader] = int32(attempts)
+	headers[lastErrorHeader] = err.Error()
+	headers[routingKeyHeader] = originalRoutingKey(d)
+
+	if err := r.publish(exchange, key, d, headers); err != nil {
+		log.Printf("Failed to move message %s, requeueing it: %v", d.MessageId, err)
+		d.Nack(false, true)
+		return
+	}
+	d.Ack(false)
+}
+
+// publish copies d to exchange with new headers and waits for the broker
+// to confirm it.
+func (r *retrier) publish(exchange, key string, d amqp.Delivery, headers amqp.Table) error {
+	err := r.pub.Publish(exchange, key, false, false, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    d.MessageId,
+		Timestamp:    d.Timestamp,
+		Body:         d.Body,
+	})
+	if err != nil {
+		return err
+	}
+	// The broker numbers publishes on a confirm mode channel from 1
+	r.published++
+	return r.awaitConfirm(r.published)
+}
+
+// awaitConfirm waits for the broker to confirm the publish with the given
+// delivery tag. Confirms for earlier publishes that timed out are skipped,
+// so a late confirm can't be taken for this one.
+func (r *retrier) awaitConfirm(tag uint64) error {
+	timeout := time.After(confirmTimeout)
+	for {
+		select {
+		case confirm, ok := <-r.confirms:
+			if !ok {
+				return amqp.ErrClosed
+			}
+			if confirm.DeliveryTag < tag {
+				continue
+			}
+			if !confirm.Ack {
+				return errors.New("publish was not acknowledged by RabbitMQ")
+			}
+			return nil
+		case <-timeout:
+			return errors.New("timed out waiting for RabbitMQ to confirm the publish")
+		}
+	}
+}
+
+// deliveryAttempts is how many times the message has failed before.
+func deliveryAttempts(d amqp.Delivery) int {
+	switch v := d.Headers[attemptsHeader].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int16:
+		return int(v)
+	case int8:
+		return int(v)
+	}
+	return 0
+}
+
+// originalRoutingKey is the routing key the message was first published
+// with, e.g. "order.created". Retried messages come back from a retry
+// queue routed by queue name, so it's kept in a header.
+func originalRoutingKey(d amqp.Delivery) string {
+	if key, ok := d.Headers[routingKeyHeader].(string); ok && key != "" {
+		return key
+	}
+	return d.RoutingKey
+}
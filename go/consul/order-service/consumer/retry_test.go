@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/streadway/amqp"
+)
+
+func TestRetryLevel(t *testing.T) {
+	want := []int{0, 1, 2, 2, 2}
+	for i, level := range want {
+		attempts := i + 1
+		if got := retryLevel(attempts); got != level {
+			t.Errorf("retryLevel(%d) = %d, want %d", attempts, got, level)
+		}
+	}
+	if got := retryQueue(0); got != "order_notifications.retry.1" {
+		t.Errorf("retryQueue(0) = %q", got)
+	}
+}
+
+func TestDeliveryHeaders(t *testing.T) {
+	first := amqp.Delivery{RoutingKey: "order.created"}
+	if got := deliveryAttempts(first); got != 0 {
+		t.Errorf("deliveryAttempts() = %d, want 0", got)
+	}
+	if got := originalRoutingKey(first); got != "order.created" {
+		t.Errorf("originalRoutingKey() = %q, want order.created", got)
+	}
+
+	// Back from a retry queue, routed by queue name
+	retried := amqp.Delivery{
+		RoutingKey: queueName,
+		Headers: amqp.Table{
+			attemptsHeader:   int32(2),
+			routingKeyHeader: "order.paid",
+		},
+	}
+	if got := deliveryAttempts(retried); got != 2 {
+		t.Errorf("deliveryAttempts() = %d, want 2", got)
+	}
+	if got := originalRoutingKey(retried); got != "order.paid" {
+		t.Errorf("originalRoutingKey() = %q, want order.paid", got)
+	}
+}
+
+func TestAwaitConfirmMatchesDeliveryTag(t *testing.T) {
+	r := &retrier{confirms: make(chan amqp.Confirmation, 3)}
+
+	// A late confirm for a publish that timed out is skipped
+	r.confirms <- amqp.Confirmation{DeliveryTag: 1, Ack: true}
+	r.confirms <- amqp.Confirmation{DeliveryTag: 2, Ack: false}
+	if err := r.awaitConfirm(2); err == nil {
+		t.Error("expected the nack for publish 2 to fail")
+	}
+
+	r.confirms <- amqp.Confirmation{DeliveryTag: 3, Ack: true}
+	if err := r.awaitConfirm(3); err != nil {
+		t.Errorf("awaitConfirm(3) = %v", err)
+	}
+
+	close(r.confirms)
+	if err := r.awaitConfirm(4); err != amqp.ErrClosed {
+		t.Errorf("awaitConfirm() on a closed channel = %v, want ErrClosed", err)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	ordersExchange = "orders"
+	queueName      = "order_notifications"
+
+	// Failed messages wait in a retry queue until their TTL expires, then
+	// RabbitMQ dead-letters them back onto the main queue.
+	retryExchange = queueName + ".retry"
+
+	// Messages that ran out of attempts, or can't be parsed at all, end
+	// up in the dead-letter queue until they're replayed.
+	deadLetterExchange = queueName + ".dlx"
+	deadLetterQueue    = queueName + ".dlq"
+)
+
+// retryDelays is how long each retry waits. Attempts past the end of the
+// list reuse the last delay.
+var retryDelays = []time.Duration{
+	5 * time.Second,
+	30 * time.Second,
+	2 * time.Minute,
+}
+
+func retryQueue(level int) string {
+	return fmt.Sprintf("%s.retry.%d", queueName, level+1)
+}
+
+// retryLevel picks the retry queue for a message that has failed attempts times.
+func retryLevel(attempts int) int {
+	return min(attempts, len(retryDelays)) - 1
+}
+
+// declareTopology declares the exchanges and queues the consumer uses:
+//
+//	orders --order.*--> order_notifications
+//	order_notifications.retry --N--> order_notifications.retry.N --TTL--> order_notifications
+//	order_notifications.dlx --> order_notifications.dlq
+func declareTopology(ch *amqp.Channel) error {
+	if err := ch.ExchangeDeclare(ordersExchange, "topic", true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare exchange %s: %w", ordersExchange, err)
+	}
+	if _, err := ch.QueueDeclare(queueName, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare queue %s: %w", queueName, err)
+	}
+	if err := ch.QueueBind(queueName, "order.*", ordersExchange, false, nil); err != nil {
+		return fmt.Errorf("bind queue %s: %w", queueName, err)
+	}
+
+	if err := ch.ExchangeDeclare(retryExchange, "direct", true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare exchange %s: %w", retryExchange, err)
+	}
+	for level, delay := range retryDelays {
+		name := retryQueue(level)
+		_, err := ch.QueueDeclare(name, true, false, false, false, amqp.Table{
+			"x-message-ttl":             int32(delay / time.Millisecond),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": queueName,
+		})
+		if err != nil {
+			return fmt.Errorf("declare queue %s: %w", name, err)
+		}
+		if err := ch.QueueBind(name, name, retryExchange, false, nil); err != nil {
+			return fmt.Errorf("bind queue %s: %w", name, err)
+		}
+	}
+
+	if err := ch.ExchangeDeclare(deadLetterExchange, "fanout", true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare exchange %s: %w", deadLetterExchange, err)
+	}
+	if _, err := ch.QueueDeclare(deadLetterQueue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare queue %s: %w", deadLetterQueue, err)
+	}
+	if err := ch.QueueBind(deadLetterQueue, "", deadLetterExchange, false, nil); err != nil {
+		return fmt.Errorf("bind queue %s: %w", deadLetterQueue, err)
+	}
+	return nil
+}
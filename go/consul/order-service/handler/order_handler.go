@@ -1,27 +1,25 @@
 package handler
 
 import (
-	"encoding/json"
-	"log"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"order-service/model"
 	"order-service/repository"
 
 	"github.com/gin-gonic/gin"
-	"github.com/streadway/amqp"
 )
 
 type OrderHandler struct {
-	repo       repository.OrderRepository
-	rabbitConn *amqp.Connection
+	repo repository.OrderRepository
 }
 
-func NewOrderHandler(repo repository.OrderRepository, rabbitConn *amqp.Connection) *OrderHandler {
+func NewOrderHandler(repo repository.OrderRepository) *OrderHandler {
 	return &OrderHandler{
-		repo:       repo,
-		rabbitConn: rabbitConn,
+		repo: repo,
 	}
 }
 
@@ -32,19 +30,16 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 		return
 	}
 
-	// Set default status
-	order.Status = "pending"
+	// Every order starts out pending
+	order.Status = model.StatusPending
 
+	// The order.created event is saved with the order and published by the
+	// outbox relay
 	if err := h.repo.Create(&order); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	// Publish message to RabbitMQ
-	if err := h.publishOrderCreatedEvent(order); err != nil {
-		log.Printf("Failed to publish order created event: %v", err)
-	}
-
 	c.JSON(http.StatusCreated, order)
 }
 
@@ -64,6 +59,24 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 	c.JSON(http.StatusOK, order)
 }
 
+// Query parameters for GET /orders/user/:user_id. Status takes a comma
+// separated list. Sort by id, amount, status, created_at or updated_at,
+// with a "-" prefix for descending order.
+type listOrdersQuery struct {
+	Limit         int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor        string     `form:"cursor"`
+	Sort          string     `form:"sort"`
+	Status        string     `form:"status"`
+	MinAmount     *float64   `form:"min_amount"`
+	MaxAmount     *float64   `form:"max_amount"`
+	CreatedAfter  *time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore *time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// nextCursorHeader carries the cursor for the next page of a list, so the
+// body stays a plain array. It's left out on the last page.
+const nextCursorHeader = "Next-Cursor"
+
 func (h *OrderHandler) GetOrdersByUserID(c *gin.Context) {
 	userID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
 	if err != nil {
@@ -71,54 +84,85 @@ func (h *OrderHandler) GetOrdersByUserID(c *gin.Context) {
 		return
 	}
 
-	orders, err := h.repo.FindByUserID(uint(userID))
+	var query listOrdersQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := repository.OrderFilter{
+		MinAmount:     query.MinAmount,
+		MaxAmount:     query.MaxAmount,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Sort:          query.Sort,
+		Limit:         query.Limit,
+		Cursor:        query.Cursor,
+	}
+	for _, status := range strings.Split(query.Status, ",") {
+		if status = strings.TrimSpace(status); status != "" {
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	orders, next, err := h.repo.ListByUserID(uint(userID), filter)
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) || errors.Is(err, repository.ErrInvalidSort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if next != "" {
+		c.Header(nextCursorHeader, next)
+	}
 	c.JSON(http.StatusOK, orders)
 }
 
-// Publish order created event to RabbitMQ
-func (h *OrderHandler) publishOrderCreatedEvent(order model.Order) error {
-	ch, err := h.rabbitConn.Channel()
-	if err != nil {
-		return err
-	}
-	defer ch.Close()
-
-	// Declare exchange
-	err = ch.ExchangeDeclare(
-		"orders", // name
-		"topic",  // type
-		true,     // durable
-		false,    // auto-deleted
-		false,    // internal
-		false,    // no-wait
-		nil,      // arguments
-	)
+func (h *OrderHandler) PayOrder(c *gin.Context) {
+	h.transition(c, model.StatusPaid)
+}
+
+func (h *OrderHandler) ShipOrder(c *gin.Context) {
+	h.transition(c, model.StatusShipped)
+}
+
+func (h *OrderHandler) DeliverOrder(c *gin.Context) {
+	h.transition(c, model.StatusDelivered)
+}
+
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	h.transition(c, model.StatusCancelled)
+}
+
+func (h *OrderHandler) RefundOrder(c *gin.Context) {
+	h.transition(c, model.StatusRefunded)
+}
+
+// transition moves an order to status, recording an order.<status> event.
+// Changes the state machine doesn't allow get 409 with the current status.
+func (h *OrderHandler) transition(c *gin.Context, status string) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		return err
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
 	}
 
-	// Marshal order to JSON
-	body, err := json.Marshal(order)
+	order, err := h.repo.Transition(uint(id), status)
 	if err != nil {
-		return err
+		var invalid *model.InvalidTransitionError
+		switch {
+		case errors.As(err, &invalid):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "status": invalid.From})
+		case errors.Is(err, repository.ErrOrderNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
 	}
 
-	// Publish message
-	err = ch.Publish(
-		"orders",        // exchange
-		"order.created", // routing key
-		false,           // mandatory
-		false,           // immediate
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        body,
-		},
-	)
-
-	return err
+	c.JSON(http.StatusOK, order)
 }
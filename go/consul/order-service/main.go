@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -10,11 +11,11 @@ import (
 
 	"order-service/handler"
 	"order-service/model"
+	"order-service/outbox"
 	"order-service/repository"
 
 	"github.com/gin-gonic/gin"
 	"github.com/hashicorp/consul/api"
-	"github.com/streadway/amqp"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -25,7 +26,6 @@ var (
 	serviceName  = "order-service"
 	servicePort  = 8082
 	serviceHost  string
-	rabbitConn   *amqp.Connection
 	rabbitHost   string
 	rabbitPort   string
 )
@@ -54,19 +54,21 @@ func main() {
 	}
 
 	// Auto migrate
-	db.AutoMigrate(&model.Order{})
-
-	// Connect to RabbitMQ
-	rabbitURL := fmt.Sprintf("amqp://guest:guest@%s:%s/", rabbitHost, rabbitPort)
-	rabbitConn, err = amqp.Dial(rabbitURL)
-	if err != nil {
-		log.Fatal("Failed to connect to RabbitMQ:", err)
-	}
-	defer rabbitConn.Close()
+	db.AutoMigrate(&model.Order{}, &model.OutboxEvent{})
 
 	// Initialize repository and handler
 	orderRepo := repository.NewOrderRepository(db)
-	orderHandler := handler.NewOrderHandler(orderRepo, rabbitConn)
+	orderHandler := handler.NewOrderHandler(orderRepo)
+
+	// Publish outbox events to RabbitMQ, reconnecting as needed
+	rabbitURL := fmt.Sprintf("amqp://guest:guest@%s:%s/", rabbitHost, rabbitPort)
+	relay := outbox.NewRelay(repository.NewOutboxRepository(db), rabbitURL)
+	ctx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(relayDone)
+	}()
 
 	// Register service to Consul
 	if err := registerService(); err != nil {
@@ -85,6 +87,11 @@ func main() {
 	r.POST("/orders", orderHandler.CreateOrder)
 	r.GET("/orders/:id", orderHandler.GetOrder)
 	r.GET("/orders/user/:user_id", orderHandler.GetOrdersByUserID)
+	r.POST("/orders/:id/pay", orderHandler.PayOrder)
+	r.POST("/orders/:id/ship", orderHandler.ShipOrder)
+	r.POST("/orders/:id/deliver", orderHandler.DeliverOrder)
+	r.POST("/orders/:id/cancel", orderHandler.CancelOrder)
+	r.POST("/orders/:id/refund", orderHandler.RefundOrder)
 
 	// Graceful shutdown
 	go func() {
@@ -100,6 +107,8 @@ func main() {
 
 	// Deregister service
 	deregisterService()
+	stopRelay()
+	<-relayDone
 	log.Println("Order service stopped")
 }
 
@@ -1,6 +1,30 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// Order statuses
+const (
+	StatusPending   = "pending"
+	StatusPaid      = "paid"
+	StatusShipped   = "shipped"
+	StatusDelivered = "delivered"
+	StatusCancelled = "cancelled"
+	StatusRefunded  = "refunded"
+)
+
+// transitions lists the statuses an order can move to from each status.
+// Orders can only be cancelled before they're paid for, after that they're
+// refunded. Delivered, cancelled and refunded orders are final, except that
+// delivered orders can still be refunded.
+var transitions = map[string][]string{
+	StatusPending:   {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusShipped, StatusRefunded},
+	StatusShipped:   {StatusDelivered},
+	StatusDelivered: {StatusRefunded},
+}
 
 type Order struct {
 	ID        uint      `json:"id" gorm:"primaryKey"`
@@ -11,3 +35,23 @@ type Order struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// InvalidTransitionError reports a status change the state machine doesn't allow.
+type InvalidTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("order can't go from %s to %s", e.From, e.To)
+}
+
+// CanTransition reports whether an order may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package model
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	allowed := [][2]string{
+		{StatusPending, StatusPaid},
+		{StatusPending, StatusCancelled},
+		{StatusPaid, StatusShipped},
+		{StatusPaid, StatusRefunded},
+		{StatusShipped, StatusDelivered},
+		{StatusDelivered, StatusRefunded},
+	}
+	for _, tr := range allowed {
+		if !CanTransition(tr[0], tr[1]) {
+			t.Errorf("expected %s -> %s to be allowed", tr[0], tr[1])
+		}
+	}
+
+	denied := [][2]string{
+		{StatusPending, StatusShipped},
+		{StatusShipped, StatusCancelled},
+		{StatusCancelled, StatusPaid},
+		{StatusRefunded, StatusPaid},
+		{StatusPaid, StatusPaid},
+	}
+	for _, tr := range denied {
+		if CanTransition(tr[0], tr[1]) {
+			t.Errorf("expected %s -> %s to be rejected", tr[0], tr[1])
+		}
+	}
+}
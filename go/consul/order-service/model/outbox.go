@@ -0,0 +1,41 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"time"
+)
+
+// OutboxEvent is an event waiting to be published to RabbitMQ. It's written
+// in the same transaction as the change it describes, so an event exists
+// exactly when the change was committed. AggregateID is the order the event
+// is about; one order's events are published in the order they were written.
+type OutboxEvent struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	AggregateID   uint       `json:"aggregate_id" gorm:"index"`
+	MessageID     string     `json:"message_id" gorm:"size:64;index"`
+	RoutingKey    string     `json:"routing_key"`
+	Payload       []byte     `json:"payload"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" gorm:"index"`
+	SentAt        *time.Time `json:"sent_at" gorm:"index"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// NewOrderEvent builds the outbox event for an order, e.g. "order.created".
+// The event gets a random message ID that stays the same however many times
+// it's published, so consumers can recognise duplicates.
+func NewOrderEvent(routingKey string, order Order) (*OutboxEvent, error) {
+	payload, err := json.Marshal(order)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxEvent{
+		AggregateID:   order.ID,
+		MessageID:     rand.Text(),
+		RoutingKey:    routingKey,
+		Payload:       payload,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
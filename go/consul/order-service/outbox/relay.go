@@ -0,0 +1,184 @@
+// Package outbox publishes the events order-service writes to its outbox
+// table. Events are only marked sent once RabbitMQ confirms them, so every
+// event is delivered at least once, and possibly more than once.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"order-service/model"
+	"order-service/repository"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	exchange       = "orders"
+	batchSize      = 100
+	pollInterval   = time.Second
+	confirmTimeout = 5 * time.Second
+	maxRetryDelay  = 5 * time.Minute
+	// Long enough to publish a whole batch even if every confirm is slow
+	claimLease = batchSize * confirmTimeout
+
+	// Sent events are kept for a week, then cleaned up hourly
+	sentRetention   = 7 * 24 * time.Hour
+	cleanupInterval = time.Hour
+)
+
+var errNacked = errors.New("publish was not acknowledged by RabbitMQ")
+
+// Relay polls the outbox and publishes due events to the orders exchange.
+type Relay struct {
+	repo repository.OutboxRepository
+	url  string
+	conn *amqp.Connection
+}
+
+func NewRelay(repo repository.OutboxRepository, rabbitURL string) *Relay {
+	return &Relay{repo: repo, url: rabbitURL}
+}
+
+// Run publishes events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	defer r.close()
+
+	var lastCleanup time.Time
+	for {
+		if err := r.relayBatch(); err != nil {
+			log.Printf("Outbox relay failed: %v", err)
+		}
+
+		if time.Since(lastCleanup) >= cleanupInterval {
+			lastCleanup = time.Now()
+			if n, err := r.repo.DeleteSent(lastCleanup.Add(-sentRetention)); err != nil {
+				log.Printf("Failed to clean up sent outbox events: %v", err)
+			} else if n > 0 {
+				log.Printf("Cleaned up %d sent outbox events", n)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch claims a batch of due events and publishes it. The claim is
+// its own short transaction, so no rows stay locked while waiting on
+// RabbitMQ.
+func (r *Relay) relayBatch() error {
+	events, err := r.repo.Claim(batchSize, time.Now().Add(claimLease))
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	ch, confirms, err := r.channel()
+	if err != nil {
+		log.Printf("Outbox relay can't reach RabbitMQ: %v", err)
+		return r.repo.Release(events)
+	}
+	// Closing the channel after each batch means a confirm that arrives
+	// after its timeout can't be mistaken for a later publish's
+	defer ch.Close()
+
+	return r.publish(events, func(event model.OutboxEvent) error {
+		return publishEvent(ch, confirms, event)
+	})
+}
+
+// publish sends events in order, stopping at the first one that fails so
+// an unreachable broker isn't hit once per event. The failed event backs
+// off and the rest of the batch is released for the next poll. Later
+// events for the same order aren't claimed until the failed one is sent.
+func (r *Relay) publish(events []model.OutboxEvent, send func(model.OutboxEvent) error) error {
+	for i, event := range events {
+		if err := send(event); err != nil {
+			attempts := event.Attempts + 1
+			log.Printf("Failed to publish %s event %d (attempt %d): %v", event.RoutingKey, event.ID, attempts, err)
+			if err := r.repo.MarkFailed(event.ID, attempts, err, time.Now().Add(retryDelay(attempts))); err != nil {
+				return err
+			}
+			return r.repo.Release(events[i+1:])
+		}
+		if err := r.repo.MarkSent(event.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// channel opens a channel in confirm mode, reconnecting if RabbitMQ dropped
+// the connection.
+func (r *Relay) channel() (*amqp.Channel, chan amqp.Confirmation, error) {
+	if r.conn == nil || r.conn.IsClosed() {
+		conn, err := amqp.Dial(r.url)
+		if err != nil {
+			return nil, nil, err
+		}
+		r.conn = conn
+	}
+
+	ch, err := r.conn.Channel()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ch.ExchangeDeclare(exchange, "topic", true, false, false, false, nil); err != nil {
+		ch.Close()
+		return nil, nil, err
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, nil, err
+	}
+	return ch, ch.NotifyPublish(make(chan amqp.Confirmation, 1)), nil
+}
+
+func (r *Relay) close() {
+	if r.conn != nil && !r.conn.IsClosed() {
+		r.conn.Close()
+	}
+}
+
+// publishEvent publishes a persistent message and waits for the broker to
+// confirm it.
+func publishEvent(ch *amqp.Channel, confirms chan amqp.Confirmation, event model.OutboxEvent) error {
+	err := ch.Publish(exchange, event.RoutingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    event.MessageID,
+		Timestamp:    event.CreatedAt,
+		Body:         event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case confirm, ok := <-confirms:
+		if !ok {
+			return amqp.ErrClosed
+		}
+		if !confirm.Ack {
+			return errNacked
+		}
+		return nil
+	case <-time.After(confirmTimeout):
+		return errors.New("timed out waiting for RabbitMQ to confirm the publish")
+	}
+}
+
+// retryDelay backs off exponentially from one second, up to maxRetryDelay.
+func retryDelay(attempts int) time.Duration {
+	if attempts > 9 {
+		return maxRetryDelay
+	}
+	return min(time.Second<<(attempts-1), maxRetryDelay)
+}
@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"order-service/model"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{5, 16 * time.Second},
+		{9, 256 * time.Second},
+		{10, maxRetryDelay},
+		{100, maxRetryDelay},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+type failedEvent struct {
+	id       uint
+	attempts int
+	err      error
+	retryAt  time.Time
+}
+
+// fakeOutbox records what the relay marks.
+type fakeOutbox struct {
+	sent     []uint
+	failed   []failedEvent
+	released []uint
+}
+
+func (f *fakeOutbox) Claim(limit int, leaseUntil time.Time) ([]model.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (f *fakeOutbox) MarkSent(id uint) error {
+	f.sent = append(f.sent, id)
+	return nil
+}
+
+func (f *fakeOutbox) MarkFailed(id uint, attempts int, err error, retryAt time.Time) error {
+	f.failed = append(f.failed, failedEvent{id, attempts, err, retryAt})
+	return nil
+}
+
+func (f *fakeOutbox) Release(events []model.OutboxEvent) error {
+	for _, e := range events {
+		f.released = append(f.released, e.ID)
+	}
+	return nil
+}
+
+func (f *fakeOutbox) DeleteSent(before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestPublishMarksSent(t *testing.T) {
+	repo := &fakeOutbox{}
+	relay := NewRelay(repo, "")
+	events := []model.OutboxEvent{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	var published []uint
+	err := relay.publish(events, func(e model.OutboxEvent) error {
+		published = append(published, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(published) != 3 || len(repo.sent) != 3 || repo.sent[2] != 3 {
+		t.Errorf("published %v, marked sent %v", published, repo.sent)
+	}
+	if len(repo.failed) != 0 || len(repo.released) != 0 {
+		t.Errorf("unexpected failures %v or releases %v", repo.failed, repo.released)
+	}
+}
+
+func TestPublishStopsAtFirstFailure(t *testing.T) {
+	repo := &fakeOutbox{}
+	relay := NewRelay(repo, "")
+	events := []model.OutboxEvent{{ID: 1}, {ID: 2, Attempts: 3}, {ID: 3}, {ID: 4}}
+	errBroker := errors.New("broker unavailable")
+
+	var published []uint
+	start := time.Now()
+	err := relay.publish(events, func(e model.OutboxEvent) error {
+		published = append(published, e.ID)
+		if e.ID == 2 {
+			return errBroker
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(published) != 2 {
+		t.Errorf("published %v, want to stop at event 2", published)
+	}
+	if len(repo.sent) != 1 || repo.sent[0] != 1 {
+		t.Errorf("marked sent %v, want [1]", repo.sent)
+	}
+	if len(repo.failed) != 1 {
+		t.Fatalf("marked failed %v, want event 2", repo.failed)
+	}
+	failed := repo.failed[0]
+	if failed.id != 2 || failed.attempts != 4 || !errors.Is(failed.err, errBroker) {
+		t.Errorf("unexpected failure %+v", failed)
+	}
+	// Fourth attempt backs off 8 seconds
+	if delay := failed.retryAt.Sub(start); delay < 8*time.Second || delay > 9*time.Second {
+		t.Errorf("retry in %v, want 8s", delay)
+	}
+	if len(repo.released) != 2 || repo.released[0] != 3 || repo.released[1] != 4 {
+		t.Errorf("released %v, want [3 4]", repo.released)
+	}
+}
@@ -1,14 +1,51 @@
 package repository
 
 import (
+    "errors"
+    "time"
+
     "gorm.io/gorm"
     "order-service/model"
+    "shared/pagination"
+)
+
+var (
+    ErrOrderNotFound = errors.New("order not found")
+
+    // A filter's Sort or Cursor isn't valid for orders
+    ErrInvalidCursor = pagination.ErrInvalidCursor
+    ErrInvalidSort   = pagination.ErrInvalidSort
 )
 
+// OrderFilter selects a page of a user's orders. Statuses matches any of
+// the given statuses. The amount range is inclusive, while the date range
+// includes CreatedAfter but not CreatedBefore. Sort is a field from
+// orderSortFields, prefixed with "-" for descending order, and Cursor
+// continues from a previous page.
+type OrderFilter struct {
+    Statuses      []string
+    MinAmount     *float64
+    MaxAmount     *float64
+    CreatedAfter  *time.Time
+    CreatedBefore *time.Time
+    Sort          string
+    Limit         int
+    Cursor        string
+}
+
+var orderSortFields = map[string]pagination.SortKind{
+    "id":         pagination.SortInt,
+    "amount":     pagination.SortFloat,
+    "status":     pagination.SortString,
+    "created_at": pagination.SortTime,
+    "updated_at": pagination.SortTime,
+}
+
 type OrderRepository interface {
     Create(order *model.Order) error
     FindByID(id uint) (*model.Order, error)
-    FindByUserID(userID uint) ([]model.Order, error)
+    ListByUserID(userID uint, filter OrderFilter) ([]model.Order, string, error)
+    Transition(id uint, status string) (*model.Order, error)
 }
 
 type orderRepository struct {
@@ -19,8 +56,14 @@ func NewOrderRepository(db *gorm.DB) OrderRepository {
     return &orderRepository{db: db}
 }
 
+// Create saves the order together with its order.created event.
 func (r *orderRepository) Create(order *model.Order) error {
-    return r.db.Create(order).Error
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(order).Error; err != nil {
+            return err
+        }
+        return addOrderEvent(tx, "order.created", *order)
+    })
 }
 
 func (r *orderRepository) FindByID(id uint) (*model.Order, error) {
@@ -29,8 +72,101 @@ func (r *orderRepository) FindByID(id uint) (*model.Order, error) {
     return &order, err
 }
 
-func (r *orderRepository) FindByUserID(userID uint) ([]model.Order, error) {
-    var orders []model.Order
-    err := r.db.Where("user_id = ?", userID).Find(&orders).Error
-    return orders, err
-}
\ No newline at end of file
+// ListByUserID returns a page of a user's orders and the cursor for the
+// next page, which is empty on the last page.
+func (r *orderRepository) ListByUserID(userID uint, filter OrderFilter) ([]model.Order, string, error) {
+    query := r.db.Model(&model.Order{}).Where("user_id = ?", userID)
+    if len(filter.Statuses) > 0 {
+        query = query.Where("status IN ?", filter.Statuses)
+    }
+    if filter.MinAmount != nil {
+        query = query.Where("amount >= ?", *filter.MinAmount)
+    }
+    if filter.MaxAmount != nil {
+        query = query.Where("amount <= ?", *filter.MaxAmount)
+    }
+    if filter.CreatedAfter != nil {
+        query = query.Where("created_at >= ?", *filter.CreatedAfter)
+    }
+    if filter.CreatedBefore != nil {
+        query = query.Where("created_at < ?", *filter.CreatedBefore)
+    }
+
+    limit := pagination.PageLimit(filter.Limit)
+    query, err := pagination.Paginate(query, filter.Sort, filter.Cursor, limit, orderSortFields)
+    if err != nil {
+        return nil, "", err
+    }
+
+    orders := []model.Order{}
+    if err := query.Find(&orders).Error; err != nil {
+        return nil, "", err
+    }
+
+    orders, next := pagination.NextPage(orders, limit, filter.Sort, func(o model.Order, column string) (interface{}, uint) {
+        switch column {
+        case "amount":
+            return o.Amount, o.ID
+        case "status":
+            return o.Status, o.ID
+        case "created_at":
+            return o.CreatedAt, o.ID
+        case "updated_at":
+            return o.UpdatedAt, o.ID
+        }
+        return o.ID, o.ID
+    })
+    return orders, next, nil
+}
+
+// Transition moves an order to status if the state machine allows it, and
+// records an order.<status> event with the change. The update only applies
+// while the order still has the status it was checked against, so
+// concurrent transitions can't both win.
+func (r *orderRepository) Transition(id uint, status string) (*model.Order, error) {
+    var order model.Order
+    err := r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.First(&order, id).Error; err != nil {
+            if errors.Is(err, gorm.ErrRecordNotFound) {
+                return ErrOrderNotFound
+            }
+            return err
+        }
+
+        if !model.CanTransition(order.Status, status) {
+            return &model.InvalidTransitionError{From: order.Status, To: status}
+        }
+
+        result := tx.Model(&order).Where("status = ?", order.Status).Update("status", status)
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            // Someone else changed the status first
+            if err := tx.First(&order, id).Error; err != nil {
+                return err
+            }
+            return &model.InvalidTransitionError{From: order.Status, To: status}
+        }
+        order.Status = status
+
+        return addOrderEvent(tx, "order."+status, order)
+    })
+
+    var invalid *model.InvalidTransitionError
+    if errors.As(err, &invalid) {
+        return &order, err
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &order, nil
+}
+
+func addOrderEvent(tx *gorm.DB, routingKey string, order model.Order) error {
+    event, err := model.NewOrderEvent(routingKey, order)
+    if err != nil {
+        return err
+    }
+    return tx.Create(event).Error
+}
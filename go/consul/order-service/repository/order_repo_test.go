@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"order-service/model"
+	"shared/dbtest"
+	"shared/pagination"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func newMockRepo(t *testing.T) (OrderRepository, sqlmock.Sqlmock) {
+	db, mock := dbtest.Mock(t)
+	return NewOrderRepository(db), mock
+}
+
+var orderColumns = []string{"id", "user_id", "product_id", "amount", "status", "created_at", "updated_at"}
+
+func TestListByUserIDFilters(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	minAmount, maxAmount := 10.0, 99.5
+	after := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := after.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(`SELECT * FROM "orders" WHERE user_id = $1 AND status IN ($2,$3) AND amount >= $4 AND amount <= $5 AND created_at >= $6 AND created_at < $7 ORDER BY created_at DESC,id DESC LIMIT $8`).
+		WithArgs(7, "paid", "shipped", minAmount, maxAmount, after, before, 3).
+		WillReturnRows(sqlmock.NewRows(orderColumns).AddRow(5, 7, 1, 20.0, "paid", after, after))
+
+	orders, next, err := repo.ListByUserID(7, OrderFilter{
+		Statuses:      []string{"paid", "shipped"},
+		MinAmount:     &minAmount,
+		MaxAmount:     &maxAmount,
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+		Sort:          "-created_at",
+		Limit:         2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || next != "" {
+		t.Errorf("got %d orders and cursor %q, want 1 order on the last page", len(orders), next)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListByUserIDCursors(t *testing.T) {
+	tests := []struct {
+		sort     string
+		rows     [][3]interface{} // ID, amount, status
+		firstSQL string
+		nextSQL  string
+		nextArg  interface{}
+	}{
+		{
+			sort:     "-amount",
+			rows:     [][3]interface{}{{4, 99.5, "paid"}, {9, 12.25, "paid"}, {2, 12.25, "pending"}},
+			firstSQL: `SELECT * FROM "orders" WHERE user_id = $1 ORDER BY amount DESC,id DESC LIMIT $2`,
+			nextSQL:  `SELECT * FROM "orders" WHERE user_id = $1 AND (amount, id) < ($2, $3) ORDER BY amount DESC,id DESC LIMIT $4`,
+			nextArg:  12.25,
+		},
+		{
+			sort:     "status",
+			rows:     [][3]interface{}{{3, 5.0, "cancelled"}, {8, 5.0, "paid"}, {1, 5.0, "paid"}},
+			firstSQL: `SELECT * FROM "orders" WHERE user_id = $1 ORDER BY status ASC,id ASC LIMIT $2`,
+			nextSQL:  `SELECT * FROM "orders" WHERE user_id = $1 AND (status, id) > ($2, $3) ORDER BY status ASC,id ASC LIMIT $4`,
+			nextArg:  "paid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			repo, mock := newMockRepo(t)
+			rows := sqlmock.NewRows(orderColumns)
+			for _, r := range tt.rows {
+				rows.AddRow(r[0], 7, 1, r[1], r[2], time.Now(), time.Now())
+			}
+			mock.ExpectQuery(tt.firstSQL).WithArgs(7, 3).WillReturnRows(rows)
+
+			orders, next, err := repo.ListByUserID(7, OrderFilter{Sort: tt.sort, Limit: 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 2 || next == "" {
+				t.Fatalf("got %d orders and cursor %q", len(orders), next)
+			}
+
+			// The next page starts after the last order shown
+			mock.ExpectQuery(tt.nextSQL).
+				WithArgs(7, tt.nextArg, tt.rows[1][0], 3).
+				WillReturnRows(sqlmock.NewRows(orderColumns))
+			if _, _, err := repo.ListByUserID(7, OrderFilter{Sort: tt.sort, Limit: 2, Cursor: next}); err != nil {
+				t.Fatal(err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestListByUserIDErrors(t *testing.T) {
+	repo, _ := newMockRepo(t)
+
+	// Only orderSortFields can be sorted on
+	for _, sort := range []string{"user_id", "-product_id", "amount;drop"} {
+		if _, _, err := repo.ListByUserID(7, OrderFilter{Sort: sort}); !errors.Is(err, ErrInvalidSort) {
+			t.Errorf("sort %q: got %v, want ErrInvalidSort", sort, err)
+		}
+	}
+
+	// Cursors are checked by the pagination package, so only one from
+	// another sort is tried here
+	orders := []model.Order{{ID: 5, Status: "paid"}, {ID: 2, Status: "pending"}}
+	_, next := pagination.NextPage(orders, 1, "status", func(o model.Order, column string) (interface{}, uint) {
+		return o.Status, o.ID
+	})
+	for _, cursor := range []string{"not a cursor", next} {
+		if _, _, err := repo.ListByUserID(7, OrderFilter{Sort: "-amount", Cursor: cursor}); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("cursor %q: got %v, want ErrInvalidCursor", cursor, err)
+		}
+	}
+}
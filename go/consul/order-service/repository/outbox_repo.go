@@ -0,0 +1,90 @@
+package repository
+
+import (
+    "time"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+    "order-service/model"
+)
+
+type OutboxRepository interface {
+    // Claim picks up to limit events that are due, oldest first, and
+    // leases them until leaseUntil by pushing back their next attempt.
+    // Only the oldest unsent event of each order is claimed, so an order's
+    // events go out in order even while an earlier one is backing off.
+    // Rows another relay is claiming are skipped, so several instances can
+    // share the outbox. The claim commits straight away; events that are
+    // neither marked nor released before the lease ends are retried.
+    Claim(limit int, leaseUntil time.Time) ([]model.OutboxEvent, error)
+    MarkSent(id uint) error
+    MarkFailed(id uint, attempts int, err error, retryAt time.Time) error
+    // Release makes claimed events due again without counting an attempt.
+    Release(events []model.OutboxEvent) error
+    // DeleteSent removes events sent before the given time.
+    DeleteSent(before time.Time) (int64, error)
+}
+
+// earlierUnsent skips events with an older unsent event for the same order.
+const earlierUnsent = `NOT EXISTS (SELECT 1 FROM outbox_events earlier WHERE earlier.aggregate_id = outbox_events.aggregate_id AND earlier.sent_at IS NULL AND earlier.id < outbox_events.id)`
+
+type outboxRepository struct {
+    db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+    return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Claim(limit int, leaseUntil time.Time) ([]model.OutboxEvent, error) {
+    var events []model.OutboxEvent
+    err := r.db.Transaction(func(tx *gorm.DB) error {
+        err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+            Where("sent_at IS NULL AND next_attempt_at <= ?", time.Now()).
+            Where(earlierUnsent).
+            Order("id").
+            Limit(limit).
+            Find(&events).Error
+        if err != nil || len(events) == 0 {
+            return err
+        }
+        return tx.Model(&model.OutboxEvent{}).Where("id IN ?", eventIDs(events)).
+            Update("next_attempt_at", leaseUntil).Error
+    })
+    return events, err
+}
+
+func (r *outboxRepository) MarkSent(id uint) error {
+    return r.db.Model(&model.OutboxEvent{}).Where("id = ?", id).
+        Updates(map[string]interface{}{"sent_at": time.Now(), "last_error": ""}).Error
+}
+
+func (r *outboxRepository) MarkFailed(id uint, attempts int, err error, retryAt time.Time) error {
+    return r.db.Model(&model.OutboxEvent{}).Where("id = ?", id).
+        Updates(map[string]interface{}{
+            "attempts":        attempts,
+            "last_error":      err.Error(),
+            "next_attempt_at": retryAt,
+        }).Error
+}
+
+func (r *outboxRepository) Release(events []model.OutboxEvent) error {
+    if len(events) == 0 {
+        return nil
+    }
+    return r.db.Model(&model.OutboxEvent{}).Where("id IN ? AND sent_at IS NULL", eventIDs(events)).
+        Update("next_attempt_at", time.Now()).Error
+}
+
+func (r *outboxRepository) DeleteSent(before time.Time) (int64, error) {
+    result := r.db.Where("sent_at < ?", before).Delete(&model.OutboxEvent{})
+    return result.RowsAffected, result.Error
+}
+
+func eventIDs(events []model.OutboxEvent) []uint {
+    ids := make([]uint, len(events))
+    for i, e := range events {
+        ids[i] = e.ID
+    }
+    return ids
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"order-service/model"
+	"shared/dbtest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestClaimOldestEventPerOrder(t *testing.T) {
+	db, mock := dbtest.Mock(t)
+	repo := NewOutboxRepository(db)
+	lease := time.Now().Add(time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT * FROM "outbox_events" WHERE (sent_at IS NULL AND next_attempt_at <= $1) AND (` + earlierUnsent + `)` +
+		` ORDER BY id LIMIT $2 FOR UPDATE SKIP LOCKED`).
+		WithArgs(sqlmock.AnyArg(), 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "aggregate_id"}).AddRow(3, 1).AddRow(5, 2))
+	mock.ExpectExec(`UPDATE "outbox_events" SET "next_attempt_at"=$1 WHERE id IN ($2,$3)`).
+		WithArgs(lease, 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	events, err := repo.Claim(10, lease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].AggregateID != 1 || events[1].ID != 5 {
+		t.Errorf("claimed %+v", events)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	db, mock := dbtest.Mock(t)
+	repo := NewOutboxRepository(db)
+
+	// Nothing to release doesn't touch the database
+	if err := repo.Release(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "outbox_events" SET "next_attempt_at"=$1 WHERE id IN ($2,$3) AND sent_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), 4, 7).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	if err := repo.Release([]model.OutboxEvent{{ID: 4}, {ID: 7}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -40,6 +40,13 @@ curl -s http://localhost:8082/orders/$ORDER_ID | jq .
 echo -e "${GREEN}✓ Order retrieved${NC}"
 echo ""
 
+echo -e "${BLUE}4b. Paying for and shipping the order...${NC}"
+curl -s -X POST http://localhost:8082/orders/$ORDER_ID/pay | jq .
+curl -s -X POST http://localhost:8082/orders/$ORDER_ID/ship | jq .
+curl -s -o /dev/null -w "%{http_code}\n" -X POST http://localhost:8082/orders/$ORDER_ID/cancel
+echo -e "${GREEN}✓ Order shipped, cancelling it was rejected${NC}"
+echo ""
+
 echo -e "${BLUE}5. Getting user's orders (service-to-service call)...${NC}"
 curl -s http://localhost:8081/users/$USER_ID/orders | jq .
 echo -e "${GREEN}✓ User orders retrieved via User Service${NC}"
@@ -54,7 +61,31 @@ echo ""
 
 echo -e "${BLUE}7. Listing all users...${NC}"
 curl -s http://localhost:8081/users | jq .
+curl -s -D - -o /dev/null "http://localhost:8081/users?limit=1&sort=-created_at" | grep -i next-cursor
 echo -e "${GREEN}✓ All users listed${NC}"
 echo ""
 
+echo -e "${BLUE}8. Renaming the user with a merge patch...${NC}"
+curl -s -X PATCH http://localhost:8081/users/$USER_ID \
+  -H "Content-Type: application/merge-patch+json" \
+  -d '{"name":"Johnny Doe"}' | jq .
+echo -e "${GREEN}✓ User patched${NC}"
+echo ""
+
+echo -e "${BLUE}9. Creating a second user and taking the first user's email (expect 409)...${NC}"
+OTHER_RESPONSE=$(curl -s -X POST http://localhost:8081/users \
+  -H "Content-Type: application/json" \
+  -d '{"name":"Jane Doe","email":"jane@example.com"}')
+OTHER_ID=$(echo $OTHER_RESPONSE | grep -o '"id":[0-9]*' | grep -o '[0-9]*')
+curl -s -o /dev/null -w "%{http_code}\n" -X PUT http://localhost:8081/users/$OTHER_ID \
+  -H "Content-Type: application/json" \
+  -d '{"name":"Jane Doe","email":"john@example.com"}'
+echo -e "${GREEN}✓ Duplicate email rejected${NC}"
+echo ""
+
+echo -e "${BLUE}10. Deleting the second user...${NC}"
+curl -s -o /dev/null -w "%{http_code}\n" -X DELETE http://localhost:8081/users/$OTHER_ID
+echo -e "${GREEN}✓ User deleted${NC}"
+echo ""
+
 echo "=== All tests completed! ==="
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+)
+
+// Balancer picks which instance a request goes to.
+type Balancer interface {
+	Pick(instances []*Instance) *Instance
+}
+
+// NewBalancer returns the strategy with the given name: "round-robin",
+// "random" or "least-outstanding".
+func NewBalancer(name string) (Balancer, error) {
+	switch name {
+	case "round-robin":
+		return &RoundRobin{}, nil
+	case "random":
+		return Random{}, nil
+	case "least-outstanding":
+		return LeastOutstanding{}, nil
+	}
+	return nil, fmt.Errorf("unknown load balancing strategy %q", name)
+}
+
+// RoundRobin cycles through instances in order.
+type RoundRobin struct {
+	next atomic.Uint64
+}
+
+func (b *RoundRobin) Pick(instances []*Instance) *Instance {
+	if len(instances) == 0 {
+		return nil
+	}
+	n := b.next.Add(1) - 1
+	return instances[n%uint64(len(instances))]
+}
+
+// Random picks any instance with equal probability.
+type Random struct{}
+
+func (Random) Pick(instances []*Instance) *Instance {
+	if len(instances) == 0 {
+		return nil
+	}
+	return instances[rand.IntN(len(instances))]
+}
+
+// LeastOutstanding picks the instance with the fewest requests in flight
+// from this client, choosing randomly between ties.
+type LeastOutstanding struct{}
+
+func (LeastOutstanding) Pick(instances []*Instance) *Instance {
+	var best *Instance
+	var bestLoad int64
+	ties := 0
+	for _, inst := range instances {
+		load := inst.Outstanding()
+		switch {
+		case best == nil || load < bestLoad:
+			best, bestLoad, ties = inst, load, 1
+		case load == bestLoad:
+			// Reservoir sampling keeps each tied instance equally likely
+			ties++
+			if rand.IntN(ties) == 0 {
+				best = inst
+			}
+		}
+	}
+	return best
+}
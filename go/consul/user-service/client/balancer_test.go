@@ -0,0 +1,58 @@
+package client
+
+import "testing"
+
+func testInstances(n int) []*Instance {
+	instances := make([]*Instance, n)
+	for i := range instances {
+		instances[i] = &Instance{ID: string(rune('a' + i))}
+	}
+	return instances
+}
+
+func TestRoundRobin(t *testing.T) {
+	instances := testInstances(3)
+	b := &RoundRobin{}
+	for i := 0; i < 6; i++ {
+		if got := b.Pick(instances); got != instances[i%3] {
+			t.Errorf("pick %d = %s, want %s", i, got.ID, instances[i%3].ID)
+		}
+	}
+	if b.Pick(nil) != nil {
+		t.Error("Pick(nil) should return nil")
+	}
+}
+
+func TestLeastOutstanding(t *testing.T) {
+	instances := testInstances(3)
+	instances[0].acquire()
+	instances[0].acquire()
+	instances[2].acquire()
+
+	for i := 0; i < 10; i++ {
+		if got := (LeastOutstanding{}).Pick(instances); got != instances[1] {
+			t.Fatalf("picked %s, want b", got.ID)
+		}
+	}
+
+	// Ties are spread across instances
+	instances[1].acquire()
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		seen[(LeastOutstanding{}).Pick(instances).ID] = true
+	}
+	if len(seen) != 2 || seen["a"] {
+		t.Errorf("picked %v, want b and c", seen)
+	}
+}
+
+func TestNewBalancer(t *testing.T) {
+	for _, name := range []string{"round-robin", "random", "least-outstanding"} {
+		if _, err := NewBalancer(name); err != nil {
+			t.Errorf("NewBalancer(%q): %v", name, err)
+		}
+	}
+	if _, err := NewBalancer("fastest"); err == nil {
+		t.Error("expected an error for an unknown strategy")
+	}
+}
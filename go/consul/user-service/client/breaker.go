@@ -0,0 +1,102 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	breakerThreshold = 5
+	breakerCooldown  = 30 * time.Second
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// circuitBreaker stops calls to an instance after breakerThreshold failures
+// in a row. After breakerCooldown it lets one trial call through: if that
+// succeeds the breaker closes again, otherwise it stays open for another
+// cooldown.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	now      func() time.Time
+}
+
+func (b *circuitBreaker) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+// available reports whether allow would let a call through, without
+// starting a trial call.
+func (b *circuitBreaker) available() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		return b.clock().Sub(b.openedAt) >= breakerCooldown
+	case breakerHalfOpen:
+		return false
+	}
+	return true
+}
+
+// allow reports whether a call may go ahead. Once the cooldown is over
+// the first caller gets the trial call.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.clock().Sub(b.openedAt) < breakerCooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		// A trial call is already in flight
+		return false
+	}
+	return true
+}
+
+// record reports the outcome of an allowed call.
+func (b *circuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= breakerThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.clock()
+	}
+}
+
+// abandon gives up an allowed call without an outcome, e.g. because the
+// caller went away. A trial call is handed to the next caller.
+func (b *circuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := &circuitBreaker{now: func() time.Time { return now }}
+
+	for i := 0; i < breakerThreshold-1; i++ {
+		b.record(false)
+	}
+	if !b.allow() {
+		t.Fatal("breaker opened before reaching the threshold")
+	}
+	b.record(false)
+	if b.available() || b.allow() {
+		t.Fatal("breaker should be open after the threshold")
+	}
+
+	// One trial call after the cooldown
+	now = now.Add(breakerCooldown)
+	if !b.available() || !b.allow() {
+		t.Fatal("breaker should allow a trial call after the cooldown")
+	}
+	if b.allow() {
+		t.Fatal("only one trial call should be allowed")
+	}
+
+	// A failed trial reopens it
+	b.record(false)
+	if b.allow() {
+		t.Fatal("failed trial should reopen the breaker")
+	}
+
+	// An abandoned trial is handed to the next caller, a successful one closes it
+	now = now.Add(breakerCooldown)
+	b.allow()
+	b.abandon()
+	if !b.allow() {
+		t.Fatal("abandoned trial should be handed on")
+	}
+	b.record(true)
+	if !b.allow() || !b.allow() {
+		t.Fatal("successful trial should close the breaker")
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	watchWaitTime   = 5 * time.Minute
+	maxWatchBackoff = 30 * time.Second
+	discoveryWait   = 5 * time.Second
+)
+
+// Instance is one healthy instance of a service.
+type Instance struct {
+	ID      string
+	Address string // base URL, e.g. http://order-service:8082
+
+	outstanding atomic.Int64
+	breaker     circuitBreaker
+}
+
+// Outstanding is the number of requests this client has in flight to the instance.
+func (i *Instance) Outstanding() int64 {
+	return i.outstanding.Load()
+}
+
+func (i *Instance) acquire() { i.outstanding.Add(1) }
+func (i *Instance) release() { i.outstanding.Add(-1) }
+
+// serviceCache keeps the healthy instances of a service, refreshed with
+// Consul blocking queries. When Consul can't be reached it keeps serving
+// the last list it saw.
+type serviceCache struct {
+	health  *api.Health
+	service string
+
+	mu        sync.RWMutex
+	instances []*Instance
+	loaded    chan struct{} // closed after the first successful query
+	loadOnce  sync.Once
+}
+
+func newServiceCache(health *api.Health, service string) *serviceCache {
+	return &serviceCache{
+		health:  health,
+		service: service,
+		loaded:  make(chan struct{}),
+	}
+}
+
+// watch refreshes the cache until ctx is cancelled. Each query blocks
+// until the service's health changes or the wait time runs out.
+func (c *serviceCache) watch(ctx context.Context) {
+	var index uint64
+	backoff := time.Second
+	for {
+		opts := (&api.QueryOptions{WaitIndex: index, WaitTime: watchWaitTime}).WithContext(ctx)
+		entries, meta, err := c.health.Service(c.service, "", true, opts)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to refresh %s instances, using the last known list: %v", c.service, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxWatchBackoff)
+			continue
+		}
+		backoff = time.Second
+
+		// Start over if the index went backwards, e.g. after a Consul restart
+		if meta.LastIndex < index {
+			index = 0
+		} else {
+			index = meta.LastIndex
+		}
+		c.update(entries)
+	}
+}
+
+// update replaces the instance list, keeping the request counts of
+// instances that are still there.
+func (c *serviceCache) update(entries []*api.ServiceEntry) {
+	c.mu.Lock()
+	existing := make(map[string]*Instance, len(c.instances))
+	for _, inst := range c.instances {
+		existing[inst.ID] = inst
+	}
+
+	instances := make([]*Instance, 0, len(entries))
+	for _, e := range entries {
+		address := e.Service.Address
+		if address == "" {
+			address = e.Node.Address
+		}
+		url := fmt.Sprintf("http://%s:%d", address, e.Service.Port)
+
+		inst, ok := existing[e.Service.ID]
+		if !ok || inst.Address != url {
+			inst = &Instance{ID: e.Service.ID, Address: url}
+		}
+		instances = append(instances, inst)
+	}
+	c.instances = instances
+	c.mu.Unlock()
+
+	c.loadOnce.Do(func() { close(c.loaded) })
+}
+
+// Instances returns the cached healthy instances, waiting up to
+// discoveryWait for the first query to finish.
+func (c *serviceCache) Instances() ([]*Instance, error) {
+	select {
+	case <-c.loaded:
+	case <-time.After(discoveryWait):
+		return nil, fmt.Errorf("no %s instances discovered yet", c.service)
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.instances) == 0 {
+		return nil, fmt.Errorf("no healthy %s found", c.service)
+	}
+	return c.instances, nil
+}
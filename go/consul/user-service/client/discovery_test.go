@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestServiceCacheKeepsLastKnownList(t *testing.T) {
+	var calls atomic.Int32
+	consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("X-Consul-Index", "7")
+			fmt.Fprint(w, `[
+				{"Node": {"Address": "10.0.0.1"}, "Service": {"ID": "order-1", "Address": "order-a", "Port": 8082}},
+				{"Node": {"Address": "10.0.0.2"}, "Service": {"ID": "order-2", "Port": 8082}}
+			]`)
+		default:
+			if got := r.URL.Query().Get("index"); got != "7" {
+				t.Errorf("blocking query index = %q, want 7", got)
+			}
+			http.Error(w, "consul is down", http.StatusInternalServerError)
+		}
+	}))
+	defer consul.Close()
+
+	client, err := api.NewClient(&api.Config{Address: consul.Listener.Addr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := newServiceCache(client.Health(), "order-service")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go cache.watch(ctx)
+
+	instances, err := cache.Instances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(instances) != 2 || instances[0].Address != "http://order-a:8082" || instances[1].Address != "http://10.0.0.2:8082" {
+		t.Fatalf("unexpected instances %+v", instances)
+	}
+
+	// Failed refreshes keep the list
+	for calls.Load() < 2 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if instances, err := cache.Instances(); err != nil || len(instances) != 2 {
+		t.Errorf("Instances() = %v, %v after Consul failed", instances, err)
+	}
+}
+
+func TestServiceCacheUpdateKeepsCounts(t *testing.T) {
+	cache := newServiceCache(nil, "order-service")
+	entry := func(id, address string) *api.ServiceEntry {
+		return &api.ServiceEntry{
+			Node:    &api.Node{},
+			Service: &api.AgentService{ID: id, Address: address, Port: 8082},
+		}
+	}
+
+	cache.update([]*api.ServiceEntry{entry("order-1", "a"), entry("order-2", "b")})
+	first, _ := cache.Instances()
+	first[0].acquire()
+
+	cache.update([]*api.ServiceEntry{entry("order-1", "a"), entry("order-3", "c")})
+	second, _ := cache.Instances()
+	if second[0] != first[0] || second[0].Outstanding() != 1 {
+		t.Error("instance order-1 should keep its outstanding requests")
+	}
+	if second[1].ID != "order-3" {
+		t.Errorf("second instance = %s, want order-3", second[1].ID)
+	}
+}
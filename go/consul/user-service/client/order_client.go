@@ -1,24 +1,50 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 )
 
+const (
+	maxAttempts        = 3
+	defaultCallTimeout = 2 * time.Second
+)
+
+// ErrCircuitOpen is returned when every order service instance's circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("order service circuit breaker is open")
+
 type OrderClient struct {
 	consulClient *api.Client
+	instances    *serviceCache
+	balancer     Balancer
+	httpClient   *http.Client
+	timeout      time.Duration
 }
 
 type Order struct {
-	ID        uint    `json:"id"`
-	UserID    uint    `json:"user_id"`
-	ProductID uint    `json:"product_id"`
-	Amount    float64 `json:"amount"`
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"user_id"`
+	ProductID uint      `json:"product_id"`
+	Amount    float64   `json:"amount"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrderPage is a page of orders. NextCursor is empty on the last page.
+type OrderPage struct {
+	Orders     []Order
+	NextCursor string
 }
 
 func NewOrderClient() *OrderClient {
@@ -35,7 +61,31 @@ func NewOrderClient() *OrderClient {
 		panic(err)
 	}
 
-	return &OrderClient{consulClient: client}
+	// ORDER_LB_STRATEGY picks how requests are spread across instances
+	strategy := getEnv("ORDER_LB_STRATEGY", "round-robin")
+	balancer, err := NewBalancer(strategy)
+	if err != nil {
+		log.Printf("%v, using round-robin", err)
+		balancer = &RoundRobin{}
+	}
+
+	// Keep the healthy instances cached instead of asking Consul on every call
+	instances := newServiceCache(client.Health(), "order-service")
+	go instances.watch(context.Background())
+
+	// ORDER_CLIENT_TIMEOUT limits each attempt, e.g. "500ms"
+	timeout, err := time.ParseDuration(getEnv("ORDER_CLIENT_TIMEOUT", defaultCallTimeout.String()))
+	if err != nil || timeout <= 0 {
+		timeout = defaultCallTimeout
+	}
+
+	return &OrderClient{
+		consulClient: client,
+		instances:    instances,
+		balancer:     balancer,
+		httpClient:   &http.Client{},
+		timeout:      timeout,
+	}
 }
 
 func getEnv(key, defaultValue string) string {
@@ -46,50 +96,151 @@ func getEnv(key, defaultValue string) string {
 	return value
 }
 
-// Service discovery: get order service address from Consul
-func (c *OrderClient) getServiceAddress() (string, error) {
-	services, _, err := c.consulClient.Health().Service("order-service", "", true, nil)
+// StatusError is an unexpected response from the order service.
+type StatusError struct {
+	Status int
+	Body   string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("order service returned status %d: %s", e.Status, e.Body)
+}
+
+// retryableError marks failures another instance might not have:
+// connection errors, timeouts and 502, 503 or 504 responses.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// Service discovery: pick an order service instance from the cached list,
+// skipping instances already tried and those whose circuit breaker is open
+func (c *OrderClient) pickInstance(tried map[*Instance]bool) (*Instance, error) {
+	instances, err := c.instances.Instances()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	if len(services) == 0 {
-		return "", fmt.Errorf("no healthy order service found")
+	busy := map[*Instance]bool{}
+	for {
+		var candidates []*Instance
+		for _, inst := range instances {
+			if !tried[inst] && !busy[inst] && inst.breaker.available() {
+				candidates = append(candidates, inst)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, ErrCircuitOpen
+		}
+
+		inst := c.balancer.Pick(candidates)
+		if inst.breaker.allow() {
+			return inst, nil
+		}
+		// Another request took the breaker's trial call
+		busy[inst] = true
 	}
+}
 
-	// Simple load balancing: return first healthy service
-	service := services[0]
-	address := fmt.Sprintf("http://%s:%d", service.Service.Address, service.Service.Port)
+// GetOrdersByUserID fetches a page of a user's orders. query holds the
+// order service's pagination and filter parameters, like limit and cursor.
+func (c *OrderClient) GetOrdersByUserID(ctx context.Context, userID uint, query url.Values) (*OrderPage, error) {
+	path := fmt.Sprintf("/orders/user/%d", userID)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
 
-	return address, nil
+	var page OrderPage
+	header, err := c.get(ctx, path, &page.Orders)
+	if err != nil {
+		return nil, err
+	}
+	// The cursor for the next page comes in a header, the orders in the body
+	page.NextCursor = header.Get("Next-Cursor")
+	return &page, nil
 }
 
-func (c *OrderClient) GetOrdersByUserID(userID uint) ([]Order, error) {
-	address, err := c.getServiceAddress()
+// get fetches path from the order service into v and returns the response
+// headers, retrying failures that might not happen on another instance.
+// GET requests are idempotent, so a retry can't apply a change twice.
+func (c *OrderClient) get(ctx context.Context, path string, v interface{}) (http.Header, error) {
+	tried := map[*Instance]bool{}
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		instance, err := c.pickInstance(tried)
+		if err != nil {
+			if lastErr != nil {
+				// Nothing left to retry against
+				return nil, lastErr
+			}
+			if errors.Is(err, ErrCircuitOpen) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to get order service address: %w", err)
+		}
+		tried[instance] = true
+
+		header, err := c.call(ctx, instance, path, v)
+		var retryable *retryableError
+		if err == nil || ctx.Err() != nil || !errors.As(err, &retryable) {
+			return header, err
+		}
+		log.Printf("Order service instance %s failed, trying another: %v", instance.ID, err)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// call makes one request to instance, limited to the client's timeout, and
+// tells the instance's circuit breaker how it went.
+func (c *OrderClient) call(ctx context.Context, instance *Instance, path string, v interface{}) (http.Header, error) {
+	instance.acquire()
+	defer instance.release()
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(callCtx, http.MethodGet, instance.Address+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get order service address: %w", err)
+		instance.breaker.abandon()
+		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/orders/user/%d", address, userID)
-	resp, err := http.Get(url)
+	// The instance isn't to blame when our own caller gave up
+	fail := func(err error) error {
+		if ctx.Err() != nil {
+			instance.breaker.abandon()
+			return err
+		}
+		instance.breaker.record(false)
+		return &retryableError{err: err}
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to call order service: %w", err)
+		return nil, fail(fmt.Errorf("failed to call order service: %w", err))
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, fail(fmt.Errorf("failed to read response: %w", err))
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("order service returned status %d: %s", resp.StatusCode, string(body))
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return nil, fail(&StatusError{Status: resp.StatusCode, Body: string(body)})
+	default:
+		instance.breaker.record(resp.StatusCode < 500)
+		return nil, &StatusError{Status: resp.StatusCode, Body: string(body)}
 	}
+	instance.breaker.record(true)
 
-	var orders []Order
-	if err := json.Unmarshal(body, &orders); err != nil {
+	if err := json.Unmarshal(body, v); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
-
-	return orders, nil
+	return resp.Header, nil
 }
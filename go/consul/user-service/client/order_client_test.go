@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// newTestClient points an OrderClient at servers, in order.
+func newTestClient(t *testing.T, servers ...*httptest.Server) *OrderClient {
+	cache := newServiceCache(nil, "order-service")
+	var entries []*api.ServiceEntry
+	for i, s := range servers {
+		host, port, _ := net.SplitHostPort(s.Listener.Addr().String())
+		p, _ := strconv.Atoi(port)
+		entries = append(entries, &api.ServiceEntry{
+			Node:    &api.Node{},
+			Service: &api.AgentService{ID: fmt.Sprintf("order-%d", i+1), Address: host, Port: p},
+		})
+	}
+	cache.update(entries)
+
+	return &OrderClient{
+		instances:  cache,
+		balancer:   &RoundRobin{},
+		httpClient: &http.Client{},
+		timeout:    100 * time.Millisecond,
+	}
+}
+
+func TestGetOrdersRetriesAnotherInstance(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hung.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orders/user/7" || r.URL.Query().Get("limit") != "1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Next-Cursor", "abc")
+		fmt.Fprint(w, `[{"id": 1, "user_id": 7, "amount": 9.5}]`)
+	}))
+	defer up.Close()
+
+	c := newTestClient(t, down, hung, up)
+	page, err := c.GetOrdersByUserID(context.Background(), 7, url.Values{"limit": {"1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Orders) != 1 || page.Orders[0].Amount != 9.5 || page.NextCursor != "abc" {
+		t.Errorf("unexpected page %+v", page)
+	}
+}
+
+func TestGetOrdersDoesNotRetryClientErrors(t *testing.T) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "bad request", http.StatusBadRequest)
+	})
+	a, b := httptest.NewServer(handler), httptest.NewServer(handler)
+	defer a.Close()
+	defer b.Close()
+
+	c := newTestClient(t, a, b)
+	var status *StatusError
+	if _, err := c.GetOrdersByUserID(context.Background(), 7, nil); !errors.As(err, &status) || status.Status != http.StatusBadRequest {
+		t.Fatalf("got %v, want a 400 status error", err)
+	}
+	if calls != 1 {
+		t.Errorf("made %d calls, want 1", calls)
+	}
+}
+
+func TestGetOrdersCircuitOpen(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	c := newTestClient(t, down)
+	for i := 0; i < breakerThreshold; i++ {
+		if _, err := c.GetOrdersByUserID(context.Background(), 7, nil); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("breaker opened after %d failures", i)
+		}
+	}
+	if _, err := c.GetOrdersByUserID(context.Background(), 7, nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("got %v, want ErrCircuitOpen", err)
+	}
+}
@@ -3,10 +3,12 @@ module user-service
 go 1.25.3
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/gin-gonic/gin v1.11.0
 	github.com/hashicorp/consul/api v1.33.0
 	gorm.io/driver/postgres v1.5.11
 	gorm.io/gorm v1.31.1
+	shared v0.0.0
 )
 
 require (
@@ -62,3 +64,5 @@ require (
 	golang.org/x/tools v0.36.0 // indirect
 	google.golang.org/protobuf v1.36.9 // indirect
 )
+
+replace shared => ../../shared
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// mergePatch applies a JSON merge patch (RFC 7396) to doc: patch members
+// replace doc's, nested objects merge recursively and null removes a member.
+func mergePatch(doc any, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	docObj, ok := doc.(map[string]any)
+	if !ok {
+		docObj = make(map[string]any)
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(docObj, key)
+			continue
+		}
+		docObj[key] = mergePatch(docObj[key], value)
+	}
+	return docObj
+}
+
+// applyMergePatch patches v through its JSON form. Patches may only touch
+// v's fields, and can't remove them.
+func applyMergePatch(v any, patch map[string]any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	for key, value := range patch {
+		if _, ok := doc[key]; !ok {
+			return fmt.Errorf("field %q can't be changed", key)
+		}
+		if value == nil {
+			return fmt.Errorf("field %q can't be removed", key)
+		}
+	}
+
+	data, err = json.Marshal(mergePatch(doc, patch))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
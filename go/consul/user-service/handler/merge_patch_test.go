@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{"replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"null removes member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"null for missing member", `{"a":"b"}`, `{"c":null}`, `{"a":"b"}`},
+		{"nested merge", `{"a":{"b":"c","d":"e"}}`, `{"a":{"d":"f","g":null}}`, `{"a":{"b":"c","d":"f"}}`},
+		{"nested null removes", `{"a":{"b":"c","d":"e"}}`, `{"a":{"b":null}}`, `{"a":{"d":"e"}}`},
+		{"object replaces scalar", `{"a":"b"}`, `{"a":{"c":"d"}}`, `{"a":{"c":"d"}}`},
+		{"arrays are replaced", `{"a":["b","c"]}`, `{"a":["d"]}`, `{"a":["d"]}`},
+		{"non-object patch replaces target", `{"a":"b"}`, `["c"]`, `["c"]`},
+		{"scalar patch replaces target", `{"a":"b"}`, `"c"`, `"c"`},
+		{"object patch replaces non-object target", `["a"]`, `{"b":"c"}`, `{"b":"c"}`},
+	}
+	for _, tt := range tests {
+		var doc, patch, want any
+		json.Unmarshal([]byte(tt.doc), &doc)
+		json.Unmarshal([]byte(tt.patch), &patch)
+		json.Unmarshal([]byte(tt.want), &want)
+		if got := mergePatch(doc, patch); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   string
+		want    updateUserRequest
+		wantErr bool
+	}{
+		{"change name", `{"name":"Misty"}`, updateUserRequest{Name: "Misty", Email: "ash@example.com"}, false},
+		{"change both", `{"name":"Misty","email":"misty@example.com"}`, updateUserRequest{Name: "Misty", Email: "misty@example.com"}, false},
+		{"immutable id", `{"id":7}`, updateUserRequest{}, true},
+		{"immutable created_at", `{"name":"Misty","created_at":"2024-01-01T00:00:00Z"}`, updateUserRequest{}, true},
+		{"remove required field", `{"email":null}`, updateUserRequest{}, true},
+	}
+	for _, tt := range tests {
+		var patch map[string]any
+		json.Unmarshal([]byte(tt.patch), &patch)
+		req := updateUserRequest{Name: "Ash", Email: "ash@example.com"}
+		err := applyMergePatch(&req, patch)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", tt.name)
+			}
+			if req.Name != "Ash" {
+				t.Errorf("%s: rejected patch changed the request to %+v", tt.name, req)
+			}
+			continue
+		}
+		if err != nil || req != tt.want {
+			t.Errorf("%s: got %+v, %v, want %+v", tt.name, req, err, tt.want)
+		}
+	}
+}
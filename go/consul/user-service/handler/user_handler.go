@@ -1,15 +1,20 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"user-service/client"
 	"user-service/model"
 	"user-service/repository"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
 type UserHandler struct {
@@ -32,6 +37,10 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	}
 
 	if err := h.repo.Create(&user); err != nil {
+		if errors.Is(err, repository.ErrEmailTaken) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -39,6 +48,116 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	c.JSON(http.StatusCreated, user)
 }
 
+// Fields a client may change, for PUT and PATCH
+type updateUserRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"required,email"`
+}
+
+// Replace a user's name and email
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	var req updateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.repo.FindByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	h.saveUser(c, user, req)
+}
+
+// Update some of a user's fields with a JSON merge patch (RFC 7396)
+func (h *UserHandler) PatchUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	if ct := c.ContentType(); ct != "application/merge-patch+json" && ct != binding.MIMEJSON {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/merge-patch+json"})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var patch map[string]any
+	if err := json.Unmarshal(body, &patch); err != nil || patch == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Patch must be a JSON object"})
+		return
+	}
+
+	user, err := h.repo.FindByID(uint(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	req := updateUserRequest{Name: user.Name, Email: user.Email}
+	if err := applyMergePatch(&req, patch); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.saveUser(c, user, req)
+}
+
+func (h *UserHandler) saveUser(c *gin.Context, user *model.User, req updateUserRequest) {
+	user.Name = req.Name
+	user.Email = req.Email
+
+	if err := h.repo.Update(user); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrEmailTaken):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.Is(err, repository.ErrUserNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID"})
+		return
+	}
+
+	if err := h.repo.Delete(uint(id)); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
 func (h *UserHandler) GetUser(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
@@ -55,13 +174,50 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 	c.JSON(http.StatusOK, user)
 }
 
+// Query parameters for GET /users. Sort by id, name, email or created_at,
+// with a "-" prefix for descending order.
+type listUsersQuery struct {
+	Limit         int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor        string     `form:"cursor"`
+	Sort          string     `form:"sort"`
+	Name          string     `form:"name"`
+	Email         string     `form:"email"`
+	CreatedAfter  *time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore *time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// nextCursorHeader carries the cursor for the next page of a list, so the
+// body stays a plain array. It's left out on the last page.
+const nextCursorHeader = "Next-Cursor"
+
 func (h *UserHandler) ListUsers(c *gin.Context) {
-	users, err := h.repo.FindAll()
+	var query listUsersQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, next, err := h.repo.List(repository.UserFilter{
+		Name:          query.Name,
+		Email:         query.Email,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Sort:          query.Sort,
+		Limit:         query.Limit,
+		Cursor:        query.Cursor,
+	})
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) || errors.Is(err, repository.ErrInvalidSort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if next != "" {
+		c.Header(nextCursorHeader, next)
+	}
 	c.JSON(http.StatusOK, users)
 }
 
@@ -80,13 +236,26 @@ func (h *UserHandler) GetUserOrders(c *gin.Context) {
 		return
 	}
 
-	// Call order service
-	orders, err := h.orderClient.GetOrdersByUserID(uint(id))
+	// Call order service, giving up if the client goes away. Pagination and
+	// filter parameters are passed through.
+	page, err := h.orderClient.GetOrdersByUserID(c.Request.Context(), uint(id), c.Request.URL.Query())
+	if errors.Is(err, client.ErrCircuitOpen) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Order service is unavailable, try again later"})
+		return
+	}
+	var status *client.StatusError
+	if errors.As(err, &status) && status.Status == http.StatusBadRequest {
+		c.Data(http.StatusBadRequest, "application/json; charset=utf-8", []byte(status.Body))
+		return
+	}
 	if err != nil {
 		log.Printf("Failed to fetch orders for user %d: %v", id, err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch orders"})
 		return
 	}
 
-	c.JSON(http.StatusOK, orders)
+	if page.NextCursor != "" {
+		c.Header(nextCursorHeader, page.NextCursor)
+	}
+	c.JSON(http.StatusOK, page.Orders)
 }
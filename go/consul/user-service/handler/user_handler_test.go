@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"user-service/model"
+	"user-service/repository"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// fakeUserRepo keeps users in memory with a unique email, like the database.
+type fakeUserRepo struct {
+	users map[uint]*model.User
+}
+
+func (r *fakeUserRepo) emailTaken(email string, id uint) bool {
+	for _, u := range r.users {
+		if u.Email == email && u.ID != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *fakeUserRepo) Create(user *model.User) error {
+	if r.emailTaken(user.Email, 0) {
+		return repository.ErrEmailTaken
+	}
+	user.ID = uint(len(r.users) + 1)
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *fakeUserRepo) FindByID(id uint) (*model.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	stored := *user
+	return &stored, nil
+}
+
+// List pages through users by ID. The cursor is the last ID returned.
+func (r *fakeUserRepo) List(filter repository.UserFilter) ([]model.User, string, error) {
+	after, _ := strconv.Atoi(filter.Cursor)
+	var users []model.User
+	for id := uint(after) + 1; len(users) <= filter.Limit && int(id) <= len(r.users); id++ {
+		users = append(users, *r.users[id])
+	}
+	if len(users) <= filter.Limit {
+		return users, "", nil
+	}
+	users = users[:filter.Limit]
+	return users, strconv.Itoa(int(users[len(users)-1].ID)), nil
+}
+
+func (r *fakeUserRepo) Update(user *model.User) error {
+	if _, ok := r.users[user.ID]; !ok {
+		return repository.ErrUserNotFound
+	}
+	if r.emailTaken(user.Email, user.ID) {
+		return repository.ErrEmailTaken
+	}
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *fakeUserRepo) Delete(id uint) error {
+	if _, ok := r.users[id]; !ok {
+		return repository.ErrUserNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	repo := &fakeUserRepo{users: map[uint]*model.User{
+		1: {ID: 1, Name: "Ash", Email: "ash@example.com"},
+		2: {ID: 2, Name: "Misty", Email: "misty@example.com"},
+	}}
+	h := &UserHandler{repo: repo}
+
+	r := gin.New()
+	r.GET("/users", h.ListUsers)
+	r.POST("/users", h.CreateUser)
+	r.GET("/users/:id", h.GetUser)
+	r.PUT("/users/:id", h.UpdateUser)
+	r.PATCH("/users/:id", h.PatchUser)
+	r.DELETE("/users/:id", h.DeleteUser)
+	return r
+}
+
+func TestUserHandlerStatuses(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"create", "POST", "/users", "application/json", `{"name":"Brock","email":"brock@example.com"}`, http.StatusCreated},
+		{"create with taken email", "POST", "/users", "application/json", `{"name":"Ash","email":"ash@example.com"}`, http.StatusConflict},
+		{"put", "PUT", "/users/1", "application/json", `{"name":"Ash K","email":"ash@example.com"}`, http.StatusOK},
+		{"put with taken email", "PUT", "/users/1", "application/json", `{"name":"Ash","email":"misty@example.com"}`, http.StatusConflict},
+		{"put unknown user", "PUT", "/users/9", "application/json", `{"name":"Ash","email":"ash9@example.com"}`, http.StatusNotFound},
+		{"put missing field", "PUT", "/users/1", "application/json", `{"name":"Ash"}`, http.StatusBadRequest},
+		{"patch", "PATCH", "/users/1", "application/merge-patch+json", `{"name":"Ash K"}`, http.StatusOK},
+		{"patch with taken email", "PATCH", "/users/1", "application/merge-patch+json", `{"email":"misty@example.com"}`, http.StatusConflict},
+		{"patch unknown user", "PATCH", "/users/9", "application/merge-patch+json", `{"name":"Ash"}`, http.StatusNotFound},
+		{"patch immutable field", "PATCH", "/users/1", "application/merge-patch+json", `{"id":5}`, http.StatusBadRequest},
+		{"patch invalid email", "PATCH", "/users/1", "application/merge-patch+json", `{"email":"nope"}`, http.StatusBadRequest},
+		{"patch wrong content type", "PATCH", "/users/1", "text/plain", `{"name":"Ash"}`, http.StatusUnsupportedMediaType},
+		{"get unknown user", "GET", "/users/9", "", "", http.StatusNotFound},
+		{"delete unknown user", "DELETE", "/users/9", "", "", http.StatusNotFound},
+		{"delete", "DELETE", "/users/2", "", "", http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		r := newTestRouter()
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		if tt.contentType != "" {
+			req.Header.Set("Content-Type", tt.contentType)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s: got %d (%s), want %d", tt.name, w.Code, w.Body, tt.want)
+		}
+	}
+}
+
+func TestListUsersCursorHeader(t *testing.T) {
+	r := newTestRouter()
+	list := func(path string) ([]model.User, string) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s: got %d: %s", path, w.Code, w.Body)
+		}
+		// The body stays a plain array
+		var users []model.User
+		if err := json.Unmarshal(w.Body.Bytes(), &users); err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		return users, w.Header().Get(nextCursorHeader)
+	}
+
+	users, next := list("/users?limit=1")
+	if len(users) != 1 || users[0].Name != "Ash" || next == "" {
+		t.Fatalf("got %v and cursor %q", users, next)
+	}
+	users, next = list("/users?limit=1&cursor=" + next)
+	if len(users) != 1 || users[0].Name != "Misty" || next != "" {
+		t.Errorf("got %v and cursor %q on the last page", users, next)
+	}
+}
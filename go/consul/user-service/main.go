@@ -42,7 +42,7 @@ func main() {
 	// Connect to database
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
 		dbHost, dbUser, dbPassword, dbName, dbPort)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to connect to database:", err)
 	}
@@ -70,6 +70,9 @@ func main() {
 	// User endpoints
 	r.POST("/users", userHandler.CreateUser)
 	r.GET("/users/:id", userHandler.GetUser)
+	r.PUT("/users/:id", userHandler.UpdateUser)
+	r.PATCH("/users/:id", userHandler.PatchUser)
+	r.DELETE("/users/:id", userHandler.DeleteUser)
 	r.GET("/users", userHandler.ListUsers)
 	r.GET("/users/:id/orders", userHandler.GetUserOrders)
 
@@ -1,14 +1,50 @@
 package repository
 
 import (
+    "errors"
+    "strings"
+    "time"
+
     "gorm.io/gorm"
+    "shared/pagination"
     "user-service/model"
 )
 
+var (
+    ErrUserNotFound = errors.New("user not found")
+    ErrEmailTaken   = errors.New("email already in use")
+
+    // A filter's Sort or Cursor isn't valid for users
+    ErrInvalidCursor = pagination.ErrInvalidCursor
+    ErrInvalidSort   = pagination.ErrInvalidSort
+)
+
+// UserFilter selects a page of users. Name and Email match substrings,
+// ignoring case. Sort is a field from userSortFields, prefixed with "-"
+// for descending order, and Cursor continues from a previous page.
+type UserFilter struct {
+    Name          string
+    Email         string
+    CreatedAfter  *time.Time
+    CreatedBefore *time.Time
+    Sort          string
+    Limit         int
+    Cursor        string
+}
+
+var userSortFields = map[string]pagination.SortKind{
+    "id":         pagination.SortInt,
+    "name":       pagination.SortString,
+    "email":      pagination.SortString,
+    "created_at": pagination.SortTime,
+}
+
 type UserRepository interface {
     Create(user *model.User) error
     FindByID(id uint) (*model.User, error)
-    FindAll() ([]model.User, error)
+    List(filter UserFilter) ([]model.User, string, error)
+    Update(user *model.User) error
+    Delete(id uint) error
 }
 
 type userRepository struct {
@@ -20,7 +56,7 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 }
 
 func (r *userRepository) Create(user *model.User) error {
-    return r.db.Create(user).Error
+    return translateError(r.db.Create(user).Error)
 }
 
 func (r *userRepository) FindByID(id uint) (*model.User, error) {
@@ -29,8 +65,83 @@ func (r *userRepository) FindByID(id uint) (*model.User, error) {
     return &user, err
 }
 
-func (r *userRepository) FindAll() ([]model.User, error) {
-    var users []model.User
-    err := r.db.Find(&users).Error
-    return users, err
-}
\ No newline at end of file
+// List returns a page of users and the cursor for the next page, which is
+// empty on the last page.
+func (r *userRepository) List(filter UserFilter) ([]model.User, string, error) {
+    query := r.db.Model(&model.User{})
+    if filter.Name != "" {
+        query = query.Where("name ILIKE ?", containsPattern(filter.Name))
+    }
+    if filter.Email != "" {
+        query = query.Where("email ILIKE ?", containsPattern(filter.Email))
+    }
+    if filter.CreatedAfter != nil {
+        query = query.Where("created_at >= ?", *filter.CreatedAfter)
+    }
+    if filter.CreatedBefore != nil {
+        query = query.Where("created_at < ?", *filter.CreatedBefore)
+    }
+
+    limit := pagination.PageLimit(filter.Limit)
+    query, err := pagination.Paginate(query, filter.Sort, filter.Cursor, limit, userSortFields)
+    if err != nil {
+        return nil, "", err
+    }
+
+    users := []model.User{}
+    if err := query.Find(&users).Error; err != nil {
+        return nil, "", err
+    }
+
+    users, next := pagination.NextPage(users, limit, filter.Sort, func(u model.User, column string) (interface{}, uint) {
+        switch column {
+        case "name":
+            return u.Name, u.ID
+        case "email":
+            return u.Email, u.ID
+        case "created_at":
+            return u.CreatedAt, u.ID
+        }
+        return u.ID, u.ID
+    })
+    return users, next, nil
+}
+
+// containsPattern is a LIKE pattern matching s anywhere, with LIKE's
+// wildcards in s escaped.
+func containsPattern(s string) string {
+    s = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+    return "%" + s + "%"
+}
+
+// Update saves the user's name and email.
+func (r *userRepository) Update(user *model.User) error {
+    result := r.db.Model(user).Select("name", "email").Updates(user)
+    if result.Error != nil {
+        return translateError(result.Error)
+    }
+    if result.RowsAffected == 0 {
+        return ErrUserNotFound
+    }
+    return r.db.First(user, user.ID).Error
+}
+
+func (r *userRepository) Delete(id uint) error {
+    result := r.db.Delete(&model.User{}, id)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrUserNotFound
+    }
+    return nil
+}
+
+// translateError maps the unique email index violation to ErrEmailTaken.
+// It relies on gorm.Config.TranslateError.
+func translateError(err error) error {
+    if errors.Is(err, gorm.ErrDuplicatedKey) {
+        return ErrEmailTaken
+    }
+    return err
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"shared/dbtest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var userColumns = []string{"id", "name", "email", "created_at", "updated_at"}
+
+func TestList(t *testing.T) {
+	db, mock := dbtest.Mock(t)
+	repo := NewUserRepository(db)
+	after := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT * FROM "users" WHERE name ILIKE $1 AND created_at >= $2 ORDER BY name DESC,id DESC LIMIT $3`).
+		WithArgs("%ash%", after, 3).
+		WillReturnRows(sqlmock.NewRows(userColumns).
+			AddRow(4, "Ash Ketchum", "ash@example.com", after, after).
+			AddRow(9, "Ash", "ash2@example.com", after, after).
+			AddRow(2, "Ash", "ash3@example.com", after, after))
+
+	users, next, err := repo.List(UserFilter{Name: "ash", CreatedAfter: &after, Sort: "-name", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || next == "" {
+		t.Fatalf("got %d users and cursor %q", len(users), next)
+	}
+
+	// The next page starts after the last user shown
+	mock.ExpectQuery(`SELECT * FROM "users" WHERE (name, id) < ($1, $2) ORDER BY name DESC,id DESC LIMIT $3`).
+		WithArgs("Ash", 9, 3).
+		WillReturnRows(sqlmock.NewRows(userColumns))
+	if _, _, err := repo.List(UserFilter{Sort: "-name", Limit: 2, Cursor: next}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	if _, _, err := repo.List(UserFilter{Sort: "password"}); !errors.Is(err, ErrInvalidSort) {
+		t.Errorf("got %v, want ErrInvalidSort", err)
+	}
+	if _, _, err := repo.List(UserFilter{Sort: "name", Cursor: next}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("got %v, want ErrInvalidCursor for a cursor from another sort", err)
+	}
+}
+
+func TestContainsPattern(t *testing.T) {
+	if got := containsPattern(`50%_off\`); got != `%50\%\_off\\%` {
+		t.Errorf("got %s", got)
+	}
+}
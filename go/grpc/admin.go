@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWarmConcurrency = 8
+	maxWarmConcurrency     = 32
+	maxWarmQueries         = 2000
+)
+
+type adminServer struct {
+	pb.UnimplementedAdminServiceServer
+	api   *pokeAPI
+	token string // Admin RPCs are disabled when empty
+}
+
+// authorize checks the "authorization: Bearer <token>" metadata.
+func (s *adminServer) authorize(ctx context.Context) error {
+	if s.token == "" {
+		return status.Error(codes.PermissionDenied, "admin RPCs are disabled")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+type warmResult struct {
+	query   string
+	err     error
+	skipped bool
+}
+
+func (s *adminServer) WarmCache(req *pb.WarmCacheRequest, stream pb.AdminService_WarmCacheServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx); err != nil {
+		return err
+	}
+
+	queries, err := warmQueries(req)
+	if err != nil {
+		return err
+	}
+
+	concurrency := int(req.Concurrency)
+	if concurrency <= 0 {
+		concurrency = defaultWarmConcurrency
+	}
+	concurrency = min(concurrency, maxWarmConcurrency)
+
+	log.Printf("Warming cache with %d Pokemon (concurrency %d)", len(queries), concurrency)
+
+	// Cancelling ctx (e.g. the client closing the stream) stops the workers
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan string)
+	results := make(chan warmResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for query := range jobs {
+				result := warmResult{query: query}
+				if s.api.cachedPokemon(query) {
+					result.skipped = true
+				} else {
+					_, result.err = s.api.getPokemon(ctx, query)
+				}
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, query := range queries {
+			select {
+			case jobs <- query:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	start := time.Now()
+	progress := &pb.WarmCacheProgress{Total: int32(len(queries))}
+	for result := range results {
+		progress.LastQuery = result.query
+		progress.LastError = ""
+		switch {
+		case result.skipped:
+			progress.Skipped++
+		case result.err != nil:
+			progress.Failed++
+			progress.LastError = fetchError(result.err)
+		default:
+			progress.Fetched++
+		}
+
+		completed := progress.Fetched + progress.Failed + progress.Skipped
+		elapsed := time.Since(start)
+		progress.ElapsedMs = elapsed.Milliseconds()
+		progress.EtaMs = elapsed.Milliseconds() * int64(progress.Total-completed) / int64(completed)
+		progress.Done = completed == progress.Total
+
+		if err := stream.Send(progress); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		log.Printf("Cache warm-up cancelled after %d of %d", progress.Fetched+progress.Failed+progress.Skipped, progress.Total)
+		return status.FromContextError(err).Err()
+	}
+
+	log.Printf("Cache warm-up finished: %d fetched, %d failed, %d skipped", progress.Fetched, progress.Failed, progress.Skipped)
+	return nil
+}
+
+// warmQueries turns a request into the list of Pokemon queries to prefetch.
+func warmQueries(req *pb.WarmCacheRequest) ([]string, error) {
+	var queries []string
+	if len(req.Names) > 0 {
+		for _, name := range req.Names {
+			if query := normalizeQuery(name); query != "" {
+				queries = append(queries, query)
+			}
+		}
+	} else {
+		if req.FromId < 1 || req.ToId < req.FromId {
+			return nil, status.Error(codes.InvalidArgument, "provide names or a valid ID range")
+		}
+		for id := req.FromId; id <= req.ToId && len(queries) <= maxWarmQueries; id++ {
+			queries = append(queries, strconv.Itoa(int(id)))
+		}
+	}
+
+	if len(queries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "provide names or a valid ID range")
+	}
+	if len(queries) > maxWarmQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "can't warm more than %d Pokemon at once", maxWarmQueries)
+	}
+	return queries, nil
+}
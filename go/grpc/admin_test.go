@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type warmStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	progress []*pb.WarmCacheProgress
+}
+
+func (s *warmStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *warmStream) Send(p *pb.WarmCacheProgress) error {
+	s.progress = append(s.progress, &pb.WarmCacheProgress{
+		Total:   p.Total,
+		Fetched: p.Fetched,
+		Failed:  p.Failed,
+		Skipped: p.Skipped,
+		Done:    p.Done,
+	})
+	return nil
+}
+
+func adminContext(ctx context.Context, authorization ...string) context.Context {
+	md := metadata.MD{}
+	for _, a := range authorization {
+		md.Append("authorization", a)
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestAdminAuthorize(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		header []string
+		want   codes.Code
+	}{
+		{"disabled", "", []string{"Bearer secret"}, codes.PermissionDenied},
+		{"missing header", "secret", nil, codes.Unauthenticated},
+		{"wrong token", "secret", []string{"Bearer guess"}, codes.Unauthenticated},
+		{"not a bearer token", "secret", []string{"secret"}, codes.Unauthenticated},
+		{"valid", "secret", []string{"Basic abc", "Bearer secret"}, codes.OK},
+	}
+	for _, tt := range tests {
+		s := &adminServer{token: tt.token}
+		err := s.authorize(adminContext(context.Background(), tt.header...))
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWarmQueries(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.WarmCacheRequest
+		want int
+		code codes.Code
+	}{
+		{"range", &pb.WarmCacheRequest{FromId: 1, ToId: 151}, 151, codes.OK},
+		{"names", &pb.WarmCacheRequest{Names: []string{" Pikachu", "", "eevee"}}, 2, codes.OK},
+		{"empty", &pb.WarmCacheRequest{}, 0, codes.InvalidArgument},
+		{"blank names", &pb.WarmCacheRequest{Names: []string{" "}}, 0, codes.InvalidArgument},
+		{"backwards", &pb.WarmCacheRequest{FromId: 10, ToId: 5}, 0, codes.InvalidArgument},
+		{"zero start", &pb.WarmCacheRequest{FromId: 0, ToId: 5}, 0, codes.InvalidArgument},
+		{"too many", &pb.WarmCacheRequest{FromId: 1, ToId: maxWarmQueries + 1}, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		queries, err := warmQueries(tt.req)
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.code)
+		}
+		if len(queries) != tt.want {
+			t.Errorf("%s: got %d queries, want %d", tt.name, len(queries), tt.want)
+		}
+	}
+}
+
+func TestWarmCache(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		if _, err := fmt.Sscanf(r.URL.Path, "/pokemon/%d", &id); err != nil || id > 3 {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%d,"name":"pokemon-%d"}`, id, id)
+	}))
+	defer upstream.Close()
+
+	s := &adminServer{api: newPokeAPI(upstream.URL), token: "secret"}
+	if _, err := s.api.getPokemon(context.Background(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &warmStream{ctx: adminContext(context.Background(), "Bearer secret")}
+	if err := s.WarmCache(&pb.WarmCacheRequest{FromId: 1, ToId: 4}, stream); err != nil {
+		t.Fatal(err)
+	}
+	last := stream.progress[len(stream.progress)-1]
+	if len(stream.progress) != 4 || !last.Done || last.Fetched != 2 || last.Failed != 1 || last.Skipped != 1 {
+		t.Errorf("unexpected final progress %+v after %d updates", last, len(stream.progress))
+	}
+
+	// Unauthenticated calls don't warm anything
+	stream = &warmStream{ctx: adminContext(context.Background())}
+	if err := s.WarmCache(&pb.WarmCacheRequest{FromId: 1, ToId: 4}, stream); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v, want Unauthenticated", err)
+	}
+}
+
+func TestWarmCacheSkipsDefaultVariety(t *testing.T) {
+	var fetches atomic.Int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		switch r.URL.Path {
+		case "/pokemon-species/deoxys":
+			fmt.Fprint(w, `{"id":386,"name":"deoxys","varieties":[{"is_default":true,"pokemon":{"name":"deoxys-normal"}}]}`)
+		case "/pokemon/deoxys-normal":
+			fmt.Fprint(w, `{"id":386,"name":"deoxys-normal"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer upstream.Close()
+
+	s := &adminServer{api: newPokeAPI(upstream.URL), token: "secret"}
+	if _, err := s.api.getPokemon(context.Background(), "deoxys"); err != nil {
+		t.Fatal(err)
+	}
+	before := fetches.Load()
+
+	// Deoxys is cached under its default variety's name
+	stream := &warmStream{ctx: adminContext(context.Background(), "Bearer secret")}
+	if err := s.WarmCache(&pb.WarmCacheRequest{Names: []string{"Deoxys"}}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if last := stream.progress[len(stream.progress)-1]; last.Skipped != 1 {
+		t.Errorf("unexpected final progress %+v", last)
+	}
+	if got := fetches.Load(); got != before {
+		t.Errorf("warming made %d PokeAPI calls, want none", got-before)
+	}
+}
+
+func TestWarmCacheCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(adminContext(context.Background(), "Bearer secret"))
+	defer cancel()
+
+	// The client goes away as soon as the first fetch starts
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer upstream.Close()
+
+	s := &adminServer{api: newPokeAPI(upstream.URL), token: "secret"}
+	stream := &warmStream{ctx: ctx}
+	err := s.WarmCache(&pb.WarmCacheRequest{FromId: 1, ToId: 100, Concurrency: 2}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("got %v, want Canceled", err)
+	}
+	for _, p := range stream.progress {
+		if p.Done {
+			t.Error("a cancelled warm-up shouldn't report done")
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 50
+
+func (s *pokemonServer) GetPokemons(ctx context.Context, req *pb.PokemonsRequest) (*pb.PokemonsResponse, error) {
+	if len(req.Queries) == 0 || len(req.Queries) > maxBatchSize {
+		return &pb.PokemonsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Please enter between 1 and %d Pokemon", maxBatchSize),
+		}, nil
+	}
+
+	log.Printf("Fetching batch of %d Pokemon", len(req.Queries))
+
+	// Queries that name the same Pokemon are only fetched once
+	results := make([]*pb.PokemonResponse, len(req.Queries))
+	var queries []string
+	var indexes [][]int
+	seen := make(map[string]int)
+	for i, q := range req.Queries {
+		query := normalizeQuery(q)
+		if query == "" {
+			results[i] = &pb.PokemonResponse{
+				Success: false,
+				Message: "Please enter a Pokemon name or ID",
+			}
+			continue
+		}
+		slug := pokemonSlug(query)
+		if j, ok := seen[slug]; ok {
+			indexes[j] = append(indexes[j], i)
+			continue
+		}
+		seen[slug] = len(queries)
+		queries = append(queries, query)
+		indexes = append(indexes, []int{i})
+	}
+
+	found := 0
+	err := s.api.fetchOrdered(ctx, queries, defaultFetchConcurrency, func(i int, data *PokeAPIResponse, err error) error {
+		var result *pb.PokemonResponse
+		if err != nil {
+			result = s.api.notFoundResponse(ctx, queries[i], err)
+		} else {
+			pokemon := toPokemon(data)
+			s.trends.record(pokemon.Id, pokemon.Name)
+			result = &pb.PokemonResponse{
+				Success: true,
+				Message: "Pokemon found!",
+				Pokemon: pokemon,
+			}
+			found += len(indexes[i])
+		}
+		for _, j := range indexes[i] {
+			results[j] = result
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	return &pb.PokemonsResponse{
+		Success: found > 0,
+		Message: fmt.Sprintf("Found %d of %d Pokemon", found, len(req.Queries)),
+		Results: results,
+	}, nil
+}
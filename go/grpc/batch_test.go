@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "grpc/proto"
+)
+
+// newBatchServer serves Pokemon 1-9 and counts requests per path.
+func newBatchServer(t *testing.T) (*pokemonServer, func(path string) int) {
+	var mu sync.Mutex
+	fetches := make(map[string]int)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetches[r.URL.Path]++
+		mu.Unlock()
+
+		if r.URL.Path == "/pokemon" {
+			fmt.Fprint(w, `{"results":[{"name":"pokemon-1"},{"name":"pokemon-2"}]}`)
+			return
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/pokemon/"))
+		if err != nil || id < 1 || id > 9 {
+			http.NotFound(w, r)
+			return
+		}
+		// Later IDs answer faster, so fetches complete out of order
+		time.Sleep(time.Duration(10-id) * time.Millisecond)
+		fmt.Fprintf(w, `{"id":%d,"name":"pokemon-%d"}`, id, id)
+	}))
+	t.Cleanup(upstream.Close)
+
+	s := &pokemonServer{api: newPokeAPI(upstream.URL), trends: newTrendStore("")}
+	return s, func(path string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches[path]
+	}
+}
+
+func TestGetPokemonsKeepsRequestOrder(t *testing.T) {
+	s, fetches := newBatchServer(t)
+
+	resp, err := s.GetPokemons(context.Background(), &pb.PokemonsRequest{
+		Queries: []string{"4", "pokemon-x", "1", "", " 4 ", "9"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Success || resp.Message != "Found 4 of 6 Pokemon" {
+		t.Errorf("unexpected summary %v %q", resp.Success, resp.Message)
+	}
+
+	want := []int32{4, 0, 1, 0, 4, 9}
+	for i, r := range resp.Results {
+		if want[i] == 0 {
+			if r.Success {
+				t.Errorf("result %d: expected a failure, got %v", i, r.Pokemon)
+			}
+			continue
+		}
+		if !r.Success || r.Pokemon.Id != want[i] {
+			t.Errorf("result %d: got %v, want Pokemon %d", i, r, want[i])
+		}
+	}
+	if got := resp.Results[1].Suggestions; len(got) == 0 {
+		t.Error("not found result should have suggestions")
+	}
+	if got := resp.Results[3].Message; got != "Please enter a Pokemon name or ID" {
+		t.Errorf("unexpected message for an empty query: %q", got)
+	}
+
+	if n := fetches("/pokemon/4"); n != 1 {
+		t.Errorf("duplicate query fetched %d times", n)
+	}
+}
+
+func TestGetPokemonsLimits(t *testing.T) {
+	s, _ := newBatchServer(t)
+	for _, n := range []int{0, maxBatchSize + 1} {
+		resp, err := s.GetPokemons(context.Background(), &pb.PokemonsRequest{Queries: make([]string, n)})
+		if err != nil || resp.Success {
+			t.Errorf("batch of %d: expected an unsuccessful response, got %v, %v", n, resp, err)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+const (
+	undiscoveredEggGroup = "no-eggs"
+	// PokeAPI: an egg hatches after 255 × (hatch_counter + 1) steps
+	stepsPerEggCycle = 255
+)
+
+// First stages whose eggs can also hatch into a second species.
+var alternateOffspring = map[string]string{
+	"nidoran-f": "nidoran-m",
+	"illumise":  "volbeat",
+}
+
+// Mothers whose eggs always hatch into a different species.
+var replacedOffspring = map[string]string{
+	"manaphy": "phione",
+}
+
+// PokeAPI evolution chain response
+type PokeAPIEvolutionChain struct {
+	BabyTriggerItem *struct {
+		Name string `json:"name"`
+	} `json:"baby_trigger_item"`
+	Chain evolutionLink `json:"chain"`
+}
+
+type evolutionLink struct {
+	IsBaby  bool `json:"is_baby"`
+	Species struct {
+		Name string `json:"name"`
+	} `json:"species"`
+	EvolvesTo []evolutionLink `json:"evolves_to"`
+}
+
+// getEvolutionChain fetches the chain a species links to.
+func (a *pokeAPI) getEvolutionChain(ctx context.Context, url string) (*PokeAPIEvolutionChain, error) {
+	var data PokeAPIEvolutionChain
+	if err := a.get(ctx, "evolution-chain/"+path.Base(url), &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// evolutionPath lists the species from the root of the chain down to species.
+func evolutionPath(link evolutionLink, species string) []string {
+	if link.Species.Name == species {
+		return []string{species}
+	}
+	for _, next := range link.EvolvesTo {
+		if p := evolutionPath(next, species); p != nil {
+			return append([]string{link.Species.Name}, p...)
+		}
+	}
+	return nil
+}
+
+type eggOption struct {
+	species string
+	incense string // Held by a parent to hatch a baby form
+}
+
+// eggSpecies lists what an egg laid by mother can hatch into: the first
+// stage of her evolution chain. Baby forms that need an incense only hatch
+// when a parent holds it, otherwise the egg is the next stage.
+func eggSpecies(chain *PokeAPIEvolutionChain, mother string) []eggOption {
+	if species, ok := replacedOffspring[mother]; ok {
+		return []eggOption{{species: species}}
+	}
+
+	stages := evolutionPath(chain.Chain, mother)
+	if len(stages) == 0 {
+		stages = []string{mother}
+	}
+
+	var options []eggOption
+	if chain.Chain.IsBaby && chain.BabyTriggerItem != nil && len(stages) > 1 {
+		options = append(options,
+			eggOption{species: stages[0], incense: chain.BabyTriggerItem.Name},
+			eggOption{species: stages[1]},
+		)
+	} else {
+		options = append(options, eggOption{species: stages[0]})
+	}
+
+	if species, ok := alternateOffspring[stages[0]]; ok {
+		options = append(options, eggOption{species: species})
+	}
+	return options
+}
+
+type breeder struct {
+	name       string
+	eggGroups  []string
+	genderRate int // Chance of being female in eighths, -1 for genderless
+}
+
+func newBreeder(data *PokeAPISpecies) breeder {
+	b := breeder{name: data.Name, genderRate: data.GenderRate}
+	for _, g := range data.EggGroups {
+		b.eggGroups = append(b.eggGroups, g.Name)
+	}
+	return b
+}
+
+func (b breeder) ditto() bool        { return b.name == "ditto" }
+func (b breeder) genderless() bool   { return b.genderRate < 0 }
+func (b breeder) canBeFemale() bool  { return b.genderRate > 0 }
+func (b breeder) canBeMale() bool    { return b.genderRate >= 0 && b.genderRate < 8 }
+func (b breeder) undiscovered() bool { return hasEggGroup(b.eggGroups, undiscoveredEggGroup) }
+
+func hasEggGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// canBreed applies the breeding rules to two Pokemon, returning why they
+// can or can't breed and the egg groups they share.
+func canBreed(a, b breeder) (bool, string, []string) {
+	var shared []string
+	for _, g := range a.eggGroups {
+		if hasEggGroup(b.eggGroups, g) {
+			shared = append(shared, g)
+		}
+	}
+
+	aName, bName := displayName(a.name), displayName(b.name)
+	switch {
+	case a.undiscovered():
+		return false, fmt.Sprintf("%s is in the Undiscovered egg group and can't breed", aName), shared
+	case b.undiscovered():
+		return false, fmt.Sprintf("%s is in the Undiscovered egg group and can't breed", bName), shared
+	case a.ditto() && b.ditto():
+		return false, "Two Ditto can't breed with each other", shared
+	case a.ditto() || b.ditto():
+		return true, "Ditto can breed with any Pokemon outside the Undiscovered egg group", shared
+	case a.genderless():
+		return false, fmt.Sprintf("%s is genderless and can only breed with Ditto", aName), shared
+	case b.genderless():
+		return false, fmt.Sprintf("%s is genderless and can only breed with Ditto", bName), shared
+	case !(a.canBeFemale() && b.canBeMale()) && !(b.canBeFemale() && a.canBeMale()):
+		return false, fmt.Sprintf("%s and %s can't be opposite genders", aName, bName), shared
+	case len(shared) == 0:
+		return false, fmt.Sprintf("%s and %s don't share an egg group", aName, bName), shared
+	}
+	return true, fmt.Sprintf("Both are in the %s egg group", displayName(shared[0])), shared
+}
+
+// mothers lists which parents can lay the egg. With Ditto, the other
+// parent's species always hatches.
+func mothers(a, b breeder) []breeder {
+	switch {
+	case a.ditto():
+		return []breeder{b}
+	case b.ditto():
+		return []breeder{a}
+	}
+	var result []breeder
+	if a.canBeFemale() && b.canBeMale() {
+		result = append(result, a)
+	}
+	if b.canBeFemale() && a.canBeMale() && b.name != a.name {
+		result = append(result, b)
+	}
+	return result
+}
+
+func toBreedingParent(b breeder) *pb.BreedingParent {
+	parent := &pb.BreedingParent{
+		Name:       displayName(b.name),
+		EggGroups:  b.eggGroups,
+		Genderless: b.genderless(),
+	}
+	if !b.genderless() {
+		parent.FemalePercent = float64(b.genderRate) * 12.5
+	}
+	return parent
+}
+
+// breedingSpecies resolves a query, including forms like "vulpix-alola",
+// to the species it belongs to.
+func (a *pokeAPI) breedingSpecies(ctx context.Context, query string) (*PokeAPISpecies, error) {
+	data, err := a.getPokemon(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return a.getSpecies(ctx, data.Species.Name)
+}
+
+func (s *pokemonServer) CheckBreeding(ctx context.Context, req *pb.BreedingRequest) (*pb.BreedingResponse, error) {
+	first, second := normalizeQuery(req.First), normalizeQuery(req.Second)
+
+	if first == "" || second == "" {
+		return &pb.BreedingResponse{
+			Success: false,
+			Message: "Please enter two Pokemon",
+		}, nil
+	}
+
+	log.Printf("Checking breeding: %s and %s", first, second)
+
+	var parents [2]breeder
+	for i, query := range []string{first, second} {
+		species, err := s.api.breedingSpecies(ctx, query)
+		if err != nil {
+			return &pb.BreedingResponse{
+				Success: false,
+				Message: fetchError(err),
+			}, nil
+		}
+		parents[i] = newBreeder(species)
+	}
+
+	compatible, reason, shared := canBreed(parents[0], parents[1])
+	resp := &pb.BreedingResponse{
+		Success:         true,
+		Message:         reason,
+		Compatible:      compatible,
+		Parents:         []*pb.BreedingParent{toBreedingParent(parents[0]), toBreedingParent(parents[1])},
+		SharedEggGroups: shared,
+	}
+	if !compatible {
+		return resp, nil
+	}
+
+	for _, mother := range mothers(parents[0], parents[1]) {
+		species, err := s.api.getSpecies(ctx, mother.name)
+		if err == nil {
+			var chain *PokeAPIEvolutionChain
+			chain, err = s.api.getEvolutionChain(ctx, species.EvolutionChain.URL)
+			if err == nil {
+				err = s.addOffspring(ctx, resp, mother.name, eggSpecies(chain, mother.name))
+			}
+		}
+		if err != nil {
+			return &pb.BreedingResponse{
+				Success: false,
+				Message: fetchError(err),
+			}, nil
+		}
+	}
+
+	var names []string
+	for _, o := range resp.Offspring {
+		names = append(names, o.Species)
+	}
+	resp.Message = fmt.Sprintf("%s. The egg hatches into %s", reason, strings.Join(names, " or "))
+	return resp, nil
+}
+
+// addOffspring adds what an egg from mother can hatch into, with its hatch steps.
+func (s *pokemonServer) addOffspring(ctx context.Context, resp *pb.BreedingResponse, mother string, options []eggOption) error {
+	for _, option := range options {
+		species, err := s.api.getSpecies(ctx, option.species)
+		if err != nil {
+			return err
+		}
+		resp.Offspring = append(resp.Offspring, &pb.BreedingOffspring{
+			Species:    displayName(option.species),
+			Mother:     displayName(mother),
+			Incense:    displayName(option.incense),
+			EggCycles:  int32(species.HatchCounter),
+			HatchSteps: int32(stepsPerEggCycle * (species.HatchCounter + 1)),
+		})
+	}
+	return nil
+}
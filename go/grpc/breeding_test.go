@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCanBreed(t *testing.T) {
+	charmander := breeder{name: "charmander", eggGroups: []string{"monster", "dragon"}, genderRate: 1}
+	garchomp := breeder{name: "garchomp", eggGroups: []string{"monster", "dragon"}, genderRate: 4}
+	pikachu := breeder{name: "pikachu", eggGroups: []string{"ground", "fairy"}, genderRate: 4}
+	ditto := breeder{name: "ditto", eggGroups: []string{"ditto"}, genderRate: -1}
+	magnemite := breeder{name: "magnemite", eggGroups: []string{"mineral"}, genderRate: -1}
+	mewtwo := breeder{name: "mewtwo", eggGroups: []string{"no-eggs"}, genderRate: -1}
+	tauros := breeder{name: "tauros", eggGroups: []string{"field"}, genderRate: 0}
+	miltank := breeder{name: "miltank", eggGroups: []string{"field"}, genderRate: 8}
+
+	tests := []struct {
+		name string
+		a, b breeder
+		want bool
+	}{
+		{"shared egg group", charmander, garchomp, true},
+		{"no shared egg group", charmander, pikachu, false},
+		{"ditto", ditto, pikachu, true},
+		{"genderless with ditto", magnemite, ditto, true},
+		{"two ditto", ditto, ditto, false},
+		{"genderless", magnemite, pikachu, false},
+		{"undiscovered", mewtwo, ditto, false},
+		{"male only with female only", tauros, miltank, true},
+		{"male only with male only", tauros, tauros, false},
+	}
+	for _, tt := range tests {
+		if got, reason, _ := canBreed(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: canBreed = %v (%s), want %v", tt.name, got, reason, tt.want)
+		}
+	}
+
+	if got := mothers(tauros, miltank); len(got) != 1 || got[0].name != "miltank" {
+		t.Errorf("expected Miltank to be the mother, got %v", got)
+	}
+	if got := mothers(ditto, magnemite); len(got) != 1 || got[0].name != "magnemite" {
+		t.Errorf("expected Magnemite to be the mother, got %v", got)
+	}
+}
+
+func TestEggSpecies(t *testing.T) {
+	var marill PokeAPIEvolutionChain
+	err := json.Unmarshal([]byte(`{
+		"baby_trigger_item": {"name": "sea-incense"},
+		"chain": {"is_baby": true, "species": {"name": "azurill"}, "evolves_to": [
+			{"species": {"name": "marill"}, "evolves_to": [{"species": {"name": "azumarill"}, "evolves_to": []}]}
+		]}
+	}`), &marill)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := eggSpecies(&marill, "azumarill")
+	if len(got) != 2 || got[0] != (eggOption{"azurill", "sea-incense"}) || got[1] != (eggOption{"marill", ""}) {
+		t.Errorf("unexpected Azumarill eggs: %v", got)
+	}
+
+	var nidoran PokeAPIEvolutionChain
+	err = json.Unmarshal([]byte(`{
+		"chain": {"species": {"name": "nidoran-f"}, "evolves_to": [
+			{"species": {"name": "nidorina"}, "evolves_to": [{"species": {"name": "nidoqueen"}, "evolves_to": []}]}
+		]}
+	}`), &nidoran)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got = eggSpecies(&nidoran, "nidoran-f")
+	if len(got) != 2 || got[0].species != "nidoran-f" || got[1].species != "nidoran-m" {
+		t.Errorf("unexpected Nidoran eggs: %v", got)
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	pb "grpc/proto"
+)
+
+const (
+	minCompare = 2
+	maxCompare = 6
+)
+
+// Base stats in the order PokeAPI and the games list them.
+var statNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+func (s *pokemonServer) ComparePokemon(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
+	if len(req.Queries) < minCompare || len(req.Queries) > maxCompare {
+		return &pb.CompareResponse{
+			Success: false,
+			Message: fmt.Sprintf("Please enter between %d and %d Pokemon to compare", minCompare, maxCompare),
+		}, nil
+	}
+
+	queries := make([]string, len(req.Queries))
+	for i, q := range req.Queries {
+		queries[i] = normalizeQuery(q)
+		if queries[i] == "" {
+			return &pb.CompareResponse{
+				Success: false,
+				Message: "Please enter a Pokemon name or ID",
+			}, nil
+		}
+	}
+
+	log.Printf("Comparing Pokemon: %s", strings.Join(queries, ", "))
+
+	pokemon := make([]*pb.Pokemon, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := s.api.getPokemon(ctx, q)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			pokemon[i] = toPokemon(data)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return &pb.CompareResponse{
+				Success: false,
+				Message: fmt.Sprintf("%s: %s", queries[i], fetchError(err)),
+			}, nil
+		}
+	}
+
+	return comparePokemon(pokemon), nil
+}
+
+// comparePokemon builds the comparison table for already fetched Pokemon.
+func comparePokemon(pokemon []*pb.Pokemon) *pb.CompareResponse {
+	resp := &pb.CompareResponse{
+		Success: true,
+		Message: fmt.Sprintf("Compared %d Pokemon", len(pokemon)),
+		Pokemon: pokemon,
+	}
+
+	totals := make([]int32, len(pokemon))
+	for _, name := range statNames {
+		values := make([]int32, len(pokemon))
+		for i, p := range pokemon {
+			values[i] = baseStat(p, name)
+			totals[i] += values[i]
+		}
+		resp.Stats = append(resp.Stats, comparisonRow(name, values))
+	}
+	resp.Stats = append(resp.Stats, comparisonRow("total", totals))
+
+	heights := make([]int32, len(pokemon))
+	weights := make([]int32, len(pokemon))
+	for i, p := range pokemon {
+		heights[i] = p.Height
+		weights[i] = p.Weight
+	}
+	resp.Measurements = []*pb.ComparisonRow{
+		comparisonRow("height", heights),
+		comparisonRow("weight", weights),
+	}
+
+	resp.SharedTypes = sharedTypes(pokemon)
+
+	for i, attacker := range pokemon {
+		for j, defender := range pokemon {
+			if i == j {
+				continue
+			}
+			resp.Matchups = append(resp.Matchups, matchup(i, j, attacker, defender))
+		}
+	}
+
+	return resp
+}
+
+func baseStat(p *pb.Pokemon, name string) int32 {
+	for _, s := range p.Stats {
+		if s.Name == name {
+			return s.BaseStat
+		}
+	}
+	return 0
+}
+
+func comparisonRow(name string, values []int32) *pb.ComparisonRow {
+	row := &pb.ComparisonRow{
+		Name:   name,
+		Values: values,
+		Deltas: make([]int32, len(values)),
+	}
+	for i, v := range values {
+		row.Deltas[i] = v - values[0]
+		if v > values[row.BestIndex] {
+			row.BestIndex = int32(i)
+		}
+	}
+	return row
+}
+
+func sharedTypes(pokemon []*pb.Pokemon) []string {
+	var shared []string
+	for _, t := range pokemon[0].Types {
+		inAll := true
+		for _, p := range pokemon[1:] {
+			if !hasType(p, t) {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			shared = append(shared, t)
+		}
+	}
+	return shared
+}
+
+func hasType(p *pb.Pokemon, t string) bool {
+	for _, pt := range p.Types {
+		if strings.EqualFold(pt, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchup(i, j int, attacker, defender *pb.Pokemon) *pb.Matchup {
+	m := &pb.Matchup{
+		AttackerIndex: int32(i),
+		DefenderIndex: int32(j),
+	}
+	for k, t := range attacker.Types {
+		multiplier := typeEffectiveness(t, defender.Types)
+		m.Multipliers = append(m.Multipliers, &pb.TypeMultiplier{Type: t, Multiplier: multiplier})
+		if k == 0 || multiplier > m.BestMultiplier {
+			m.BestMultiplier = multiplier
+		}
+	}
+	return m
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	pb "grpc/proto"
+)
+
+func TestComparePokemon(t *testing.T) {
+	pikachu := &pb.Pokemon{
+		Name:   "Pikachu",
+		Types:  []string{"Electric"},
+		Height: 4,
+		Weight: 60,
+		Stats:  []*pb.Stat{{Name: "hp", BaseStat: 35}, {Name: "speed", BaseStat: 90}},
+	}
+	gyarados := &pb.Pokemon{
+		Name:   "Gyarados",
+		Types:  []string{"Water", "Flying"},
+		Height: 65,
+		Weight: 2350,
+		Stats:  []*pb.Stat{{Name: "hp", BaseStat: 95}, {Name: "speed", BaseStat: 81}},
+	}
+
+	resp := comparePokemon([]*pb.Pokemon{pikachu, gyarados})
+
+	hp := resp.Stats[0]
+	if hp.Name != "hp" || hp.Deltas[1] != 60 || hp.BestIndex != 1 {
+		t.Errorf("unexpected hp row: %v", hp)
+	}
+
+	total := resp.Stats[len(resp.Stats)-1]
+	if total.Name != "total" || total.Values[0] != 125 || total.Values[1] != 176 {
+		t.Errorf("unexpected total row: %v", total)
+	}
+
+	if len(resp.SharedTypes) != 0 {
+		t.Errorf("expected no shared types, got %v", resp.SharedTypes)
+	}
+
+	// Electric vs Water/Flying is 4x, Water vs Electric is neutral.
+	if len(resp.Matchups) != 2 {
+		t.Fatalf("expected 2 matchups, got %d", len(resp.Matchups))
+	}
+	if got := resp.Matchups[0].BestMultiplier; got != 4 {
+		t.Errorf("Pikachu vs Gyarados: expected 4x, got %v", got)
+	}
+	if got := resp.Matchups[1].BestMultiplier; got != 1 {
+		t.Errorf("Gyarados vs Pikachu: expected 1x, got %v", got)
+	}
+}
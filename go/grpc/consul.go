@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// consulRegistration registers the gRPC server with Consul so clients can
+// find it with consulresolver. Consul health checks it over the standard
+// gRPC health service.
+type consulRegistration struct {
+	client *api.Client
+	id     string
+}
+
+// registerService registers the server listening on port when CONSUL_HOST
+// is set, and returns nil when it isn't.
+func registerService(port int) (*consulRegistration, error) {
+	consulHost := os.Getenv("CONSUL_HOST")
+	if consulHost == "" {
+		return nil, nil
+	}
+
+	config := api.DefaultConfig()
+	config.Address = net.JoinHostPort(consulHost, getEnv("CONSUL_PORT", "8500"))
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := getEnv("SERVICE_NAME", "pokemon-service")
+	serviceHost := getEnv("SERVICE_HOST", serviceName) // Default to service name for Docker networking
+	serviceID := getEnv("SERVICE_ID", fmt.Sprintf("%s-%s-%d", serviceName, serviceHost, port))
+
+	registration := &api.AgentServiceRegistration{
+		ID:      serviceID,
+		Name:    serviceName,
+		Port:    port,
+		Address: serviceHost,
+		Tags:    []string{"grpc"},
+		Check: &api.AgentServiceCheck{
+			GRPC:                           net.JoinHostPort(serviceHost, strconv.Itoa(port)),
+			Interval:                       "10s",
+			Timeout:                        "3s",
+			DeregisterCriticalServiceAfter: "30s",
+		},
+	}
+	if err := client.Agent().ServiceRegister(registration); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Registered %s with Consul at %s", serviceID, config.Address)
+	return &consulRegistration{client: client, id: serviceID}, nil
+}
+
+func (r *consulRegistration) deregister() {
+	if r == nil {
+		return
+	}
+	if err := r.client.Agent().ServiceDeregister(r.id); err != nil {
+		log.Printf("Failed to deregister %s from Consul: %v", r.id, err)
+		return
+	}
+	log.Printf("Deregistered %s from Consul", r.id)
+}
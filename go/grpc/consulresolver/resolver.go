@@ -0,0 +1,185 @@
+// Package consulresolver lets gRPC clients dial services registered in
+// Consul, e.g. "consul://pokemon-service", and balance across the
+// instances whose health checks pass.
+//
+// Import it for its side effect of registering the "consul" scheme:
+//
+//	import _ "grpc/consulresolver"
+//
+//	conn, err := grpc.NewClient("consul://pokemon-service",
+//		grpc.WithTransportCredentials(insecure.NewCredentials()))
+//
+// The Consul agent is found with the standard CONSUL_HTTP_ADDR environment
+// variable, or can be named in the target: "consul://127.0.0.1:8500/pokemon-service".
+package consulresolver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme is the target scheme this package resolves.
+const Scheme = "consul"
+
+const (
+	// How long Consul may hold a blocking query open before answering
+	waitTime = 5 * time.Minute
+	// Delay before retrying after Consul fails
+	retryDelay = 5 * time.Second
+)
+
+// Spread calls across every healthy instance rather than picking the first.
+const serviceConfig = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+func init() {
+	resolver.Register(NewBuilder(nil))
+}
+
+type builder struct {
+	config *api.Config
+}
+
+// NewBuilder returns a resolver builder using config to reach Consul, or
+// api.DefaultConfig when config is nil. Pass it to grpc.WithResolvers to use
+// a Consul agent other than the default.
+func NewBuilder(config *api.Config) resolver.Builder {
+	return &builder{config: config}
+}
+
+func (b *builder) Scheme() string {
+	return Scheme
+}
+
+func (b *builder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	config := api.DefaultConfig()
+	if b.config != nil {
+		c := *b.config
+		config = &c
+	}
+
+	// consul://agent:8500/service names the agent, consul://service doesn't
+	service := strings.TrimPrefix(target.URL.Path, "/")
+	if service != "" {
+		if target.URL.Host != "" {
+			config.Address = target.URL.Host
+		}
+	} else {
+		service = target.URL.Host
+	}
+	if service == "" {
+		return nil, fmt.Errorf("consul resolver: no service name in target %q", target.URL.String())
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("consul resolver: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &consulResolver{
+		health:  client.Health(),
+		service: service,
+		cc:      cc,
+		ctx:     ctx,
+		cancel:  cancel,
+		resolve: make(chan struct{}, 1),
+	}
+	r.wg.Add(1)
+	go r.watch()
+	return r, nil
+}
+
+// consulResolver watches a service's healthy instances with Consul blocking
+// queries and hands each change to gRPC.
+type consulResolver struct {
+	health  *api.Health
+	service string
+	cc      resolver.ClientConn
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	resolve chan struct{}
+	wg      sync.WaitGroup
+}
+
+func (r *consulResolver) watch() {
+	defer r.wg.Done()
+
+	var index uint64
+	for {
+		opts := (&api.QueryOptions{WaitIndex: index, WaitTime: waitTime}).WithContext(r.ctx)
+		entries, meta, err := r.health.Service(r.service, "", true, opts)
+		if r.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.cc.ReportError(fmt.Errorf("consul resolver: %w", err))
+			index = 0
+			r.wait(retryDelay)
+			continue
+		}
+
+		// Consul resets the index when it goes backwards, see the blocking query docs
+		if meta.LastIndex < index {
+			index = 0
+		} else {
+			index = meta.LastIndex
+		}
+
+		if err := r.cc.UpdateState(resolver.State{
+			Addresses:     addresses(entries),
+			ServiceConfig: r.cc.ParseServiceConfig(serviceConfig),
+		}); err != nil {
+			// gRPC asks for a re-resolve when no instance was usable
+			r.wait(retryDelay)
+		}
+	}
+}
+
+// wait sleeps for d, returning early for ResolveNow or Close.
+func (r *consulResolver) wait(d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-r.resolve:
+	case <-r.ctx.Done():
+	}
+}
+
+// addresses turns Consul health entries into gRPC addresses. Services that
+// register without an address use their node's.
+func addresses(entries []*api.ServiceEntry) []resolver.Address {
+	addrs := make([]resolver.Address, 0, len(entries))
+	for _, e := range entries {
+		host := e.Service.Address
+		if host == "" {
+			host = e.Node.Address
+		}
+		addrs = append(addrs, resolver.Address{
+			Addr:       net.JoinHostPort(host, strconv.Itoa(e.Service.Port)),
+			ServerName: e.Service.Service,
+		})
+	}
+	return addrs
+}
+
+func (r *consulResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+	}
+}
+
+func (r *consulResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
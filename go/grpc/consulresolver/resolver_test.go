@@ -0,0 +1,89 @@
+package consulresolver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+)
+
+type fakeClientConn struct {
+	resolver.ClientConn
+	states chan resolver.State
+}
+
+func (cc *fakeClientConn) UpdateState(state resolver.State) error {
+	cc.states <- state
+	return nil
+}
+
+func (cc *fakeClientConn) ReportError(error) {}
+
+func (cc *fakeClientConn) ParseServiceConfig(string) *serviceconfig.ParseResult {
+	return &serviceconfig.ParseResult{}
+}
+
+func TestResolverFollowsHealthyInstances(t *testing.T) {
+	updates := make(chan string, 1)
+	consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/health/service/pokemon-service" || r.URL.Query().Get("passing") == "" {
+			http.Error(w, "unexpected query", http.StatusBadRequest)
+			return
+		}
+
+		body := `[{"Node": {"Address": "10.0.0.1"}, "Service": {"Service": "pokemon-service", "Address": "", "Port": 50051}}]`
+		index := "1"
+		if r.URL.Query().Get("index") == "1" {
+			// Block like Consul until the instances change
+			select {
+			case body = <-updates:
+				index = "2"
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.Header().Set("X-Consul-Index", index)
+		fmt.Fprint(w, body)
+	}))
+	defer consul.Close()
+
+	agent, _ := url.Parse(consul.URL)
+	target := resolver.Target{URL: url.URL{Scheme: Scheme, Host: agent.Host, Path: "/pokemon-service"}}
+	cc := &fakeClientConn{states: make(chan resolver.State, 1)}
+
+	r, err := NewBuilder(nil).Build(target, cc, resolver.BuildOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	assertAddrs(t, cc, "10.0.0.1:50051")
+
+	updates <- `[
+		{"Node": {"Address": "10.0.0.1"}, "Service": {"Service": "pokemon-service", "Address": "pokemon-1", "Port": 50051}},
+		{"Node": {"Address": "10.0.0.2"}, "Service": {"Service": "pokemon-service", "Address": "pokemon-2", "Port": 50052}}
+	]`
+	assertAddrs(t, cc, "pokemon-1:50051", "pokemon-2:50052")
+}
+
+func assertAddrs(t *testing.T, cc *fakeClientConn, want ...string) {
+	t.Helper()
+	select {
+	case state := <-cc.states:
+		if len(state.Addresses) != len(want) {
+			t.Fatalf("got %v, want %v", state.Addresses, want)
+		}
+		for i, addr := range state.Addresses {
+			if addr.Addr != want[i] {
+				t.Fatalf("got %v, want %v", state.Addresses, want)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for resolver update")
+	}
+}
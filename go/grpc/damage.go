@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+// damageInput holds everything the damage formula needs once stats are known.
+type damageInput struct {
+	level         int
+	attack        int // Attack or Sp. Atk of the attacker
+	defense       int // Defense or Sp. Def of the defender
+	power         int
+	weather       float64
+	critical      bool
+	stab          bool
+	effectiveness float64
+	other         float64
+}
+
+// damageRolls returns the 16 possible damage values, lowest first, using the
+// formula from Generation V onwards.
+func damageRolls(in damageInput) []int32 {
+	base := (2*in.level/5+2)*in.power*in.attack/in.defense/50 + 2
+
+	base = applyModifier(base, in.weather)
+	if in.critical {
+		base = applyModifier(base, 1.5)
+	}
+
+	rolls := make([]int32, 0, 16)
+	for r := 85; r <= 100; r++ {
+		damage := base * r / 100
+		if in.stab {
+			damage = applyModifier(damage, 1.5)
+		}
+		damage = int(float64(damage) * in.effectiveness)
+		damage = applyModifier(damage, in.other)
+		if damage == 0 && in.effectiveness > 0 {
+			damage = 1
+		}
+		rolls = append(rolls, int32(damage))
+	}
+	return rolls
+}
+
+// applyModifier multiplies and rounds to the nearest integer, with halves
+// rounding down like the games do.
+func applyModifier(value int, modifier float64) int {
+	v := float64(value) * modifier
+	if v-math.Floor(v) == 0.5 {
+		return int(math.Floor(v))
+	}
+	return int(math.Round(v))
+}
+
+// weatherModifier returns the damage multiplier weather applies to a move type.
+func weatherModifier(weather, moveType string) float64 {
+	switch {
+	case weather == "sun" && moveType == "fire", weather == "rain" && moveType == "water":
+		return 1.5
+	case weather == "sun" && moveType == "water", weather == "rain" && moveType == "fire":
+		return 0.5
+	}
+	return 1
+}
+
+func (s *pokemonServer) CalculateDamage(ctx context.Context, req *pb.DamageRequest) (*pb.DamageResponse, error) {
+	attackerQuery := normalizeQuery(req.Attacker)
+	defenderQuery := normalizeQuery(req.Defender)
+	moveQuery := slug(req.Move)
+
+	if attackerQuery == "" || defenderQuery == "" || moveQuery == "" {
+		return &pb.DamageResponse{
+			Success: false,
+			Message: "Please enter an attacker, a defender and a move",
+		}, nil
+	}
+
+	attackerLevel := int(req.AttackerLevel)
+	if attackerLevel == 0 {
+		attackerLevel = defaultLevel
+	}
+	defenderLevel := int(req.DefenderLevel)
+	if defenderLevel == 0 {
+		defenderLevel = defaultLevel
+	}
+	if attackerLevel < 1 || attackerLevel > 100 || defenderLevel < 1 || defenderLevel > 100 {
+		return &pb.DamageResponse{
+			Success: false,
+			Message: "Levels must be between 1 and 100",
+		}, nil
+	}
+
+	weather := normalizeQuery(req.Weather)
+	switch weather {
+	case "", "sun", "rain", "sand", "snow":
+	default:
+		return &pb.DamageResponse{
+			Success: false,
+			Message: "Weather must be one of sun, rain, sand or snow",
+		}, nil
+	}
+
+	other := req.OtherModifier
+	if other == 0 {
+		other = 1
+	}
+	if other < 0 {
+		return &pb.DamageResponse{
+			Success: false,
+			Message: "Other modifier can't be negative",
+		}, nil
+	}
+
+	log.Printf("Calculating damage: %s using %s on %s", attackerQuery, moveQuery, defenderQuery)
+
+	attacker, err := s.api.getPokemon(ctx, attackerQuery)
+	if err != nil {
+		return &pb.DamageResponse{Success: false, Message: fmt.Sprintf("%s: %s", attackerQuery, fetchError(err))}, nil
+	}
+	defender, err := s.api.getPokemon(ctx, defenderQuery)
+	if err != nil {
+		return &pb.DamageResponse{Success: false, Message: fmt.Sprintf("%s: %s", defenderQuery, fetchError(err))}, nil
+	}
+	moveData, err := s.api.getMove(ctx, moveQuery)
+	if err != nil {
+		return &pb.DamageResponse{Success: false, Message: moveFetchError(err)}, nil
+	}
+
+	move := toMove(moveData)
+	if move.Category == "status" || move.Power == 0 {
+		return &pb.DamageResponse{
+			Success: false,
+			Message: fmt.Sprintf("%s has no fixed base power", move.Name),
+			Move:    move,
+		}, nil
+	}
+
+	attackerPokemon := toPokemon(attacker)
+	defenderPokemon := toPokemon(defender)
+
+	// Without a spread we assume max IVs, no EVs and a neutral nature
+	attackStat, defenseStat := "attack", "defense"
+	if move.Category == "special" {
+		attackStat, defenseStat = "special-attack", "special-defense"
+	}
+	attackerBase, defenderBase := baseStats(attacker), baseStats(defender)
+	attack := calcStat(attackStat, attackerBase[attackStat], maxIV, 0, attackerLevel, neutralNature)
+	defense := calcStat(defenseStat, defenderBase[defenseStat], maxIV, 0, defenderLevel, neutralNature)
+	hp := calcStat("hp", defenderBase["hp"], maxIV, 0, defenderLevel, neutralNature)
+
+	// Sand boosts Rock types' Sp. Def, snow boosts Ice types' Defense
+	if weather == "sand" && defenseStat == "special-defense" && hasType(defenderPokemon, "rock") ||
+		weather == "snow" && defenseStat == "defense" && hasType(defenderPokemon, "ice") {
+		defense = defense * 3 / 2
+	}
+
+	moveType := strings.ToLower(move.Type)
+	stab := hasType(attackerPokemon, moveType)
+	effectiveness := typeEffectiveness(moveType, defenderPokemon.Types)
+
+	rolls := damageRolls(damageInput{
+		level:         attackerLevel,
+		attack:        attack,
+		defense:       defense,
+		power:         int(move.Power),
+		weather:       weatherModifier(weather, moveType),
+		critical:      req.Critical,
+		stab:          stab,
+		effectiveness: effectiveness,
+		other:         other,
+	})
+
+	minDamage, maxDamage := rolls[0], rolls[len(rolls)-1]
+	resp := &pb.DamageResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("%s's %s deals %d-%d damage to %s", attackerPokemon.Name, move.Name, minDamage, maxDamage, defenderPokemon.Name),
+		Move:          move,
+		MinDamage:     minDamage,
+		MaxDamage:     maxDamage,
+		MinPercent:    percentOf(minDamage, hp),
+		MaxPercent:    percentOf(maxDamage, hp),
+		DefenderHp:    int32(hp),
+		Rolls:         rolls,
+		Stab:          stab,
+		Effectiveness: effectiveness,
+	}
+	if maxDamage > 0 {
+		resp.MinHitsToKo = hitsToKO(maxDamage, hp)
+		resp.MaxHitsToKo = hitsToKO(max(minDamage, 1), hp)
+	}
+	return resp, nil
+}
+
+func percentOf(damage int32, hp int) float64 {
+	return math.Round(float64(damage)*1000/float64(hp)) / 10
+}
+
+func hitsToKO(damage int32, hp int) int32 {
+	return int32((hp + int(damage) - 1) / int(damage))
+}
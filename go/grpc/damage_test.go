@@ -0,0 +1,49 @@
+package main
+
+import "testing"
+
+func TestDamageRolls(t *testing.T) {
+	// Garchomp's Earthquake against Heatran, both level 50 with max IVs and no EVs
+	attack := calcStat("attack", 130, maxIV, 0, 50, neutralNature)
+	defense := calcStat("defense", 106, maxIV, 0, 50, neutralNature)
+	hp := calcStat("hp", 91, maxIV, 0, 50, neutralNature)
+	if attack != 150 || defense != 126 || hp != 166 {
+		t.Fatalf("unexpected stats: attack %d, defense %d, hp %d", attack, defense, hp)
+	}
+
+	rolls := damageRolls(damageInput{
+		level:         50,
+		attack:        attack,
+		defense:       defense,
+		power:         100,
+		weather:       1,
+		stab:          true,
+		effectiveness: typeEffectiveness("ground", []string{"Fire", "Steel"}),
+		other:         1,
+	})
+
+	if len(rolls) != 16 {
+		t.Fatalf("expected 16 rolls, got %d", len(rolls))
+	}
+	if rolls[0] != 268 || rolls[15] != 324 {
+		t.Errorf("expected 268-324 damage, got %d-%d", rolls[0], rolls[15])
+	}
+	if hitsToKO(rolls[0], hp) != 1 {
+		t.Errorf("expected a guaranteed OHKO")
+	}
+}
+
+func TestDamageRollsImmune(t *testing.T) {
+	rolls := damageRolls(damageInput{
+		level:         50,
+		attack:        100,
+		defense:       100,
+		power:         90,
+		weather:       1,
+		effectiveness: typeEffectiveness("electric", []string{"Ground"}),
+		other:         1,
+	})
+	if rolls[15] != 0 {
+		t.Errorf("expected no damage against an immune type, got %d", rolls[15])
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+// PokeAPI encounters response, one entry per location area
+type PokeAPIEncounter struct {
+	LocationArea struct {
+		Name string `json:"name"`
+	} `json:"location_area"`
+	VersionDetails []struct {
+		Version struct {
+			Name string `json:"name"`
+			URL  string `json:"url"`
+		} `json:"version"`
+		EncounterDetails []struct {
+			MinLevel        int `json:"min_level"`
+			MaxLevel        int `json:"max_level"`
+			Chance          int `json:"chance"`
+			ConditionValues []struct {
+				Name string `json:"name"`
+			} `json:"condition_values"`
+			Method struct {
+				Name string `json:"name"`
+			} `json:"method"`
+		} `json:"encounter_details"`
+	} `json:"version_details"`
+}
+
+// getEncounters fetches where a Pokemon can be found in the wild.
+func (a *pokeAPI) getEncounters(ctx context.Context, id int) ([]PokeAPIEncounter, error) {
+	var data []PokeAPIEncounter
+	if err := a.get(ctx, fmt.Sprintf("pokemon/%d/encounters", id), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// groupEncounters groups encounters by game version, in release order, and
+// only keeps version when it's set. PokeAPI lists each level slot separately,
+// so slots sharing an area, method and conditions are merged into one
+// encounter with the combined level range and chance.
+func groupEncounters(data []PokeAPIEncounter, version string) []*pb.VersionEncounters {
+	type key struct {
+		version, area, method, conditions string
+	}
+	merged := make(map[key]*pb.Encounter)
+	versionOrder := make(map[string]int)
+	byVersion := make(map[string][]*pb.Encounter)
+
+	for _, e := range data {
+		for _, v := range e.VersionDetails {
+			if version != "" && v.Version.Name != version {
+				continue
+			}
+			// Version IDs follow release order
+			versionOrder[v.Version.Name], _ = strconv.Atoi(path.Base(v.Version.URL))
+
+			for _, d := range v.EncounterDetails {
+				var conditions []string
+				for _, c := range d.ConditionValues {
+					conditions = append(conditions, c.Name)
+				}
+				sort.Strings(conditions)
+
+				k := key{v.Version.Name, e.LocationArea.Name, d.Method.Name, strings.Join(conditions, ",")}
+				if enc, ok := merged[k]; ok {
+					enc.MinLevel = min(enc.MinLevel, int32(d.MinLevel))
+					enc.MaxLevel = max(enc.MaxLevel, int32(d.MaxLevel))
+					enc.Chance = min(enc.Chance+int32(d.Chance), 100)
+					continue
+				}
+
+				enc := &pb.Encounter{
+					Area:       displayName(e.LocationArea.Name),
+					Method:     d.Method.Name,
+					MinLevel:   int32(d.MinLevel),
+					MaxLevel:   int32(d.MaxLevel),
+					Chance:     int32(d.Chance),
+					Conditions: conditions,
+				}
+				merged[k] = enc
+				byVersion[v.Version.Name] = append(byVersion[v.Version.Name], enc)
+			}
+		}
+	}
+
+	versions := make([]*pb.VersionEncounters, 0, len(byVersion))
+	for name, encounters := range byVersion {
+		sort.SliceStable(encounters, func(i, j int) bool {
+			if encounters[i].Area != encounters[j].Area {
+				return encounters[i].Area < encounters[j].Area
+			}
+			return encounters[i].Method < encounters[j].Method
+		})
+		versions = append(versions, &pb.VersionEncounters{Version: name, Encounters: encounters})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		a, b := versions[i].Version, versions[j].Version
+		if versionOrder[a] != versionOrder[b] {
+			return versionOrder[a] < versionOrder[b]
+		}
+		return a < b
+	})
+	return versions
+}
+
+func (s *pokemonServer) GetEncounters(ctx context.Context, req *pb.EncounterRequest) (*pb.EncounterResponse, error) {
+	query := normalizeQuery(req.Query)
+
+	if query == "" {
+		return &pb.EncounterResponse{
+			Success: false,
+			Message: "Please enter a Pokemon name or ID",
+		}, nil
+	}
+
+	pokeData, err := s.api.getPokemonForm(ctx, query, "")
+	if err != nil {
+		return &pb.EncounterResponse{
+			Success: false,
+			Message: fetchError(err),
+		}, nil
+	}
+
+	log.Printf("Fetching encounters: %s", pokeData.Name)
+
+	data, err := s.api.getEncounters(ctx, pokeData.ID)
+	if err != nil {
+		return &pb.EncounterResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to fetch encounters: %v", err),
+		}, nil
+	}
+
+	name := strings.Title(pokeData.Name)
+	version := slug(req.Version)
+	versions := groupEncounters(data, version)
+	if len(versions) == 0 {
+		message := fmt.Sprintf("%s can't be found in the wild", name)
+		if version != "" {
+			message = fmt.Sprintf("%s can't be found in the wild in %s", name, displayName(version))
+		}
+		return &pb.EncounterResponse{
+			Success: false,
+			Message: message,
+			Name:    name,
+		}, nil
+	}
+
+	return &pb.EncounterResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("Found %s in %d games", name, len(versions)),
+		Name:     name,
+		Versions: versions,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGroupEncounters(t *testing.T) {
+	var data []PokeAPIEncounter
+	err := json.Unmarshal([]byte(`[
+		{"location_area": {"name": "viridian-forest-area"}, "version_details": [
+			{"version": {"name": "yellow", "url": "https://pokeapi.co/api/v2/version/3/"}, "encounter_details": [
+				{"min_level": 3, "max_level": 3, "chance": 5, "condition_values": [], "method": {"name": "walk"}},
+				{"min_level": 5, "max_level": 5, "chance": 5, "condition_values": [], "method": {"name": "walk"}}
+			]},
+			{"version": {"name": "red", "url": "https://pokeapi.co/api/v2/version/1/"}, "encounter_details": [
+				{"min_level": 3, "max_level": 5, "chance": 5, "condition_values": [], "method": {"name": "walk"}}
+			]}
+		]},
+		{"location_area": {"name": "pallet-town-area"}, "version_details": [
+			{"version": {"name": "yellow", "url": "https://pokeapi.co/api/v2/version/3/"}, "encounter_details": [
+				{"min_level": 5, "max_level": 5, "chance": 100, "condition_values": [], "method": {"name": "gift"}}
+			]}
+		]}
+	]`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versions := groupEncounters(data, "")
+	if len(versions) != 2 || versions[0].Version != "red" || versions[1].Version != "yellow" {
+		t.Fatalf("expected red then yellow, got %v", versions)
+	}
+
+	yellow := versions[1].Encounters
+	if len(yellow) != 2 {
+		t.Fatalf("expected 2 yellow encounters, got %v", yellow)
+	}
+	if gift := yellow[0]; gift.Area != "Pallet Town Area" || gift.Method != "gift" {
+		t.Errorf("unexpected first encounter: %v", gift)
+	}
+	if forest := yellow[1]; forest.MinLevel != 3 || forest.MaxLevel != 5 || forest.Chance != 10 {
+		t.Errorf("expected level slots merged to 3-5 at 10%%, got %v", forest)
+	}
+
+	if filtered := groupEncounters(data, "red"); len(filtered) != 1 || filtered[0].Version != "red" {
+		t.Errorf("expected only red, got %v", filtered)
+	}
+	if filtered := groupEncounters(data, "sword"); len(filtered) != 0 {
+		t.Errorf("expected no encounters in sword, got %v", filtered)
+	}
+}
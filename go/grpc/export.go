@@ -0,0 +1,458 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	pb "grpc/proto"
+
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	exportChunkSize = 32 * 1024
+	// Rows per Parquet row group, each is written out as soon as it fills
+	parquetRowGroupRows = 128
+)
+
+var exportContentTypes = map[string]string{
+	"csv":     "text/csv",
+	"jsonl":   "application/jsonl",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+type columnType int
+
+const (
+	intColumn columnType = iota
+	stringColumn
+	boolColumn
+	listColumn
+)
+
+// exportRow is one Pokemon with the species it belongs to.
+type exportRow struct {
+	pokemon *pb.Pokemon
+	species *PokeAPISpecies
+}
+
+type exportColumn struct {
+	name  string
+	typ   columnType
+	value func(row exportRow) any // int64, string, bool or []string
+}
+
+var exportColumns = append(pokemonColumns(), speciesColumns()...)
+
+// pokemonColumns follows the Pokemon message field by field, so exports
+// pick up new fields without changes here. Stats become one column each.
+func pokemonColumns() []exportColumn {
+	var columns []exportColumn
+	fields := (&pb.Pokemon{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		if fd.Message() != nil && fd.Message().FullName() == (&pb.Stat{}).ProtoReflect().Descriptor().FullName() {
+			for _, stat := range statNames {
+				columns = append(columns, exportColumn{
+					name:  strings.ReplaceAll(stat, "-", "_"),
+					typ:   intColumn,
+					value: func(row exportRow) any { return int64(baseStat(row.pokemon, stat)) },
+				})
+			}
+			continue
+		}
+
+		column := exportColumn{name: string(fd.Name())}
+		get := func(row exportRow) protoreflect.Value { return row.pokemon.ProtoReflect().Get(fd) }
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+			column.typ = listColumn
+			column.value = func(row exportRow) any {
+				list := get(row).List()
+				values := make([]string, list.Len())
+				for i := range values {
+					values[i] = list.Get(i).String()
+				}
+				return values
+			}
+		case fd.IsList() || fd.IsMap():
+			continue
+		case fd.Kind() == protoreflect.StringKind:
+			column.typ = stringColumn
+			column.value = func(row exportRow) any { return get(row).String() }
+		case fd.Kind() == protoreflect.BoolKind:
+			column.typ = boolColumn
+			column.value = func(row exportRow) any { return get(row).Bool() }
+		case fd.Kind() == protoreflect.Int32Kind, fd.Kind() == protoreflect.Int64Kind:
+			column.typ = intColumn
+			column.value = func(row exportRow) any { return get(row).Int() }
+		default:
+			continue
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func speciesColumns() []exportColumn {
+	optionalName := func(v *struct {
+		Name string `json:"name"`
+	}) string {
+		if v == nil {
+			return ""
+		}
+		return v.Name
+	}
+
+	return []exportColumn{
+		{"species", stringColumn, func(r exportRow) any { return r.species.Name }},
+		{"generation", intColumn, func(r exportRow) any { return int64(generationOf(r.species.ID)) }},
+		{"color", stringColumn, func(r exportRow) any { return r.species.Color.Name }},
+		{"shape", stringColumn, func(r exportRow) any { return optionalName(r.species.Shape) }},
+		{"habitat", stringColumn, func(r exportRow) any { return optionalName(r.species.Habitat) }},
+		{"egg_groups", listColumn, func(r exportRow) any {
+			groups := make([]string, len(r.species.EggGroups))
+			for i, g := range r.species.EggGroups {
+				groups[i] = g.Name
+			}
+			return groups
+		}},
+		{"gender_rate", intColumn, func(r exportRow) any { return int64(r.species.GenderRate) }},
+		{"capture_rate", intColumn, func(r exportRow) any { return int64(r.species.CaptureRate) }},
+		{"base_happiness", intColumn, func(r exportRow) any {
+			if r.species.BaseHappiness == nil {
+				return int64(0)
+			}
+			return int64(*r.species.BaseHappiness)
+		}},
+		{"hatch_counter", intColumn, func(r exportRow) any { return int64(r.species.HatchCounter) }},
+		{"is_baby", boolColumn, func(r exportRow) any { return r.species.IsBaby }},
+		{"is_legendary", boolColumn, func(r exportRow) any { return r.species.IsLegendary }},
+		{"is_mythical", boolColumn, func(r exportRow) any { return r.species.IsMythical }},
+	}
+}
+
+// selectColumns picks columns by name in the order given, or all of them.
+func selectColumns(names []string) ([]exportColumn, error) {
+	if len(names) == 0 {
+		return exportColumns, nil
+	}
+
+	byName := make(map[string]exportColumn, len(exportColumns))
+	for _, c := range exportColumns {
+		byName[c.name] = c
+	}
+	seen := make(map[string]bool)
+	var columns []exportColumn
+	for _, name := range names {
+		name = normalizeQuery(name)
+		column, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("column %q selected twice", name)
+		}
+		seen[name] = true
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// exportRange works out the IDs to export from the request's ID range and
+// generation, defaulting to the whole National Pokedex.
+func exportRange(req *pb.ExportRequest) (int, int, error) {
+	from, to := 1, maxPokedexID
+	if req.Generation != 0 {
+		if req.Generation < 1 || int(req.Generation) > len(generationEnds) {
+			return 0, 0, fmt.Errorf("generation must be between 1 and %d", len(generationEnds))
+		}
+		from, to = generationRange(int(req.Generation), int(req.Generation))
+	}
+	if req.FromId != 0 {
+		from = max(from, int(req.FromId))
+	}
+	if req.ToId != 0 {
+		to = min(to, int(req.ToId))
+	}
+	if req.FromId < 0 || req.ToId < 0 || req.ToId > maxPokedexID || from > to {
+		return 0, 0, fmt.Errorf("ID range must be within 1-%d", maxPokedexID)
+	}
+	return from, to, nil
+}
+
+// matchesTypes reports whether a Pokemon has any of types, or types is empty.
+func matchesTypes(p *pb.Pokemon, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if hasType(p, strings.Title(normalizeQuery(t))) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportEncoder writes rows in one export format.
+type exportEncoder interface {
+	write(row exportRow) error
+	close() error
+}
+
+func newExportEncoder(format string, w io.Writer, columns []exportColumn) (exportEncoder, error) {
+	switch format {
+	case "csv":
+		return newCSVEncoder(w, columns)
+	case "jsonl":
+		return &jsonlEncoder{w: w, columns: columns}, nil
+	case "parquet":
+		return newParquetEncoder(w, columns), nil
+	}
+	return nil, fmt.Errorf("format must be \"csv\", \"jsonl\" or \"parquet\"")
+}
+
+type csvEncoder struct {
+	w       *csv.Writer
+	columns []exportColumn
+	record  []string
+}
+
+func newCSVEncoder(w io.Writer, columns []exportColumn) (*csvEncoder, error) {
+	e := &csvEncoder{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	for i, c := range columns {
+		e.record[i] = c.name
+	}
+	return e, e.w.Write(e.record)
+}
+
+// write formats lists as "|" separated values, e.g. "Grass|Poison".
+func (e *csvEncoder) write(row exportRow) error {
+	for i, c := range e.columns {
+		switch v := c.value(row).(type) {
+		case int64:
+			e.record[i] = strconv.FormatInt(v, 10)
+		case bool:
+			e.record[i] = strconv.FormatBool(v)
+		case []string:
+			e.record[i] = strings.Join(v, "|")
+		case string:
+			e.record[i] = v
+		}
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonlEncoder struct {
+	w       io.Writer
+	columns []exportColumn
+}
+
+// write encodes a row as a JSON object with keys in column order.
+func (e *jsonlEncoder) write(row exportRow) error {
+	line := []byte{'{'}
+	for i, c := range e.columns {
+		if i > 0 {
+			line = append(line, ',')
+		}
+		line = strconv.AppendQuote(line, c.name)
+		line = append(line, ':')
+		value, err := json.Marshal(c.value(row))
+		if err != nil {
+			return err
+		}
+		line = append(line, value...)
+	}
+	line = append(line, '}', '\n')
+	_, err := e.w.Write(line)
+	return err
+}
+
+func (e *jsonlEncoder) close() error {
+	return nil
+}
+
+type parquetEncoder struct {
+	w       *parquet.Writer
+	columns []exportColumn
+	record  reflect.Value // *struct with a field per column, reused for every row
+	rows    int
+}
+
+// newParquetEncoder maps column types to Parquet: int64, UTF-8 strings,
+// booleans and lists of strings. A parquet.Group would sort the columns by
+// name, so the schema comes from a struct built in column order instead.
+func newParquetEncoder(w io.Writer, columns []exportColumn) *parquetEncoder {
+	fields := make([]reflect.StructField, len(columns))
+	for i, c := range columns {
+		tag := c.name
+		var typ reflect.Type
+		switch c.typ {
+		case intColumn:
+			typ = reflect.TypeFor[int64]()
+		case stringColumn:
+			typ = reflect.TypeFor[string]()
+		case boolColumn:
+			typ = reflect.TypeFor[bool]()
+		case listColumn:
+			typ = reflect.TypeFor[[]string]()
+			tag += ",list"
+		}
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("Column%d", i),
+			Type: typ,
+			Tag:  reflect.StructTag(fmt.Sprintf("parquet:%q", tag)),
+		}
+	}
+	record := reflect.New(reflect.StructOf(fields))
+	schema := parquet.SchemaOf(record.Interface())
+	return &parquetEncoder{w: parquet.NewWriter(w, schema), columns: columns, record: record}
+}
+
+func (e *parquetEncoder) write(row exportRow) error {
+	record := e.record.Elem()
+	for i, c := range e.columns {
+		record.Field(i).Set(reflect.ValueOf(c.value(row)))
+	}
+	if err := e.w.Write(e.record.Interface()); err != nil {
+		return err
+	}
+	e.rows++
+	if e.rows%parquetRowGroupRows == 0 {
+		return e.w.Flush()
+	}
+	return nil
+}
+
+func (e *parquetEncoder) close() error {
+	return e.w.Close()
+}
+
+// chunkWriter batches encoded output into chunks of about exportChunkSize
+// and hands each to send.
+type chunkWriter struct {
+	buf  []byte
+	send func(data []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= exportChunkSize {
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (s *pokemonServer) ExportPokedex(req *pb.ExportRequest, stream pb.PokemonService_ExportPokedexServer) error {
+	format := normalizeQuery(req.Format)
+	if format == "" {
+		format = "csv"
+	}
+	contentType, ok := exportContentTypes[format]
+	if !ok {
+		return status.Error(codes.InvalidArgument, `format must be "csv", "jsonl" or "parquet"`)
+	}
+	columns, err := selectColumns(req.Columns)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	from, to, err := exportRange(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("Exporting Pokedex %d-%d as %s", from, to, format)
+
+	// A cold export makes two PokeAPI fetches per Pokemon, far more than the
+	// upstream burst, so it waits for tokens rather than failing part way
+	if limit := callLimitFromContext(stream.Context()); limit != nil {
+		limit.pace()
+	}
+
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+
+	rows := 0
+	first, done := true, false
+	out := &chunkWriter{send: func(data []byte) error {
+		chunk := &pb.ExportChunk{Data: data, Rows: int32(rows), Done: done}
+		if first {
+			chunk.ContentType = contentType
+			chunk.Columns = names
+			first = false
+		}
+		return stream.Send(chunk)
+	}}
+
+	encoder, err := newExportEncoder(format, out, columns)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	fetch := func(ctx context.Context, i int) (exportRow, error) {
+		data, err := s.api.getPokemon(ctx, strconv.Itoa(from+i))
+		if err != nil {
+			return exportRow{}, err
+		}
+		species, err := s.api.getSpecies(ctx, data.Species.Name)
+		if err != nil {
+			return exportRow{}, err
+		}
+		return exportRow{pokemon: toPokemon(data), species: species}, nil
+	}
+	err = fetchOrderedFunc(stream.Context(), to-from+1, defaultFetchConcurrency, fetch, func(i int, row exportRow, err error) error {
+		if err != nil {
+			if ctxErr := stream.Context().Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return status.Errorf(codes.Unavailable, "%d: %s", from+i, fetchError(err))
+		}
+		if !matchesTypes(row.pokemon, req.Types) {
+			return nil
+		}
+		rows++
+		return encoder.write(row)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			err = status.FromContextError(err).Err()
+		}
+		return err
+	}
+
+	if err := encoder.close(); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	// The last chunk is always sent, even when empty, with the final row count
+	done = true
+	return out.send(out.buf)
+}
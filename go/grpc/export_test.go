@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "grpc/proto"
+
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	chunks []*pb.ExportChunk
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(chunk *pb.ExportChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *exportStream) data() []byte {
+	var buf bytes.Buffer
+	for _, c := range s.chunks {
+		buf.Write(c.Data)
+	}
+	return buf.Bytes()
+}
+
+func newExportServer(t *testing.T) *pokemonServer {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/pokemon/"):
+			fmt.Sscanf(r.URL.Path, "/pokemon/%d", &id)
+			typ := "grass"
+			if id == 4 {
+				typ = "fire"
+			}
+			fmt.Fprintf(w, `{"id":%d,"name":"pokemon-%d","species":{"name":"species-%d"},"types":[{"type":{"name":%q}}],
+				"stats":[{"base_stat":%d,"stat":{"name":"hp"}}]}`, id, id, id, typ, 40+id)
+		case strings.HasPrefix(r.URL.Path, "/pokemon-species/"):
+			fmt.Sscanf(r.URL.Path, "/pokemon-species/species-%d", &id)
+			fmt.Fprintf(w, `{"id":%d,"name":"species-%d","color":{"name":"green"},"habitat":null,
+				"egg_groups":[{"name":"monster"},{"name":"plant"}],"is_legendary":%v}`, id, id, id == 3)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+	return &pokemonServer{api: newPokeAPI(upstream.URL)}
+}
+
+func TestExportColumnsFollowPokemonMessage(t *testing.T) {
+	var names []string
+	for _, c := range exportColumns {
+		names = append(names, c.name)
+	}
+	got := strings.Join(names[:12], ",")
+	want := "id,name,types,image_url,height,weight,hp,attack,defense,special_attack,special_defense,speed"
+	if got != want {
+		t.Errorf("columns = %s, want %s", got, want)
+	}
+
+	if _, err := selectColumns([]string{"name", "nope"}); err == nil {
+		t.Error("expected an unknown column to fail")
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	server := newExportServer(t)
+	stream := &exportStream{}
+	err := server.ExportPokedex(&pb.ExportRequest{
+		Format:  "csv",
+		Columns: []string{"id", "name", "types", "hp", "egg_groups", "is_legendary"},
+		FromId:  1,
+		ToId:    4,
+		Types:   []string{"grass"},
+	}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"id", "name", "types", "hp", "egg_groups", "is_legendary"},
+		{"1", "Pokemon-1", "Grass", "41", "monster|plant", "false"},
+		{"2", "Pokemon-2", "Grass", "42", "monster|plant", "false"},
+		{"3", "Pokemon-3", "Grass", "43", "monster|plant", "true"},
+	}
+	if fmt.Sprint(records) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", records, want)
+	}
+
+	first, last := stream.chunks[0], stream.chunks[len(stream.chunks)-1]
+	if first.ContentType != "text/csv" || len(first.Columns) != 6 {
+		t.Errorf("unexpected first chunk: %v", first)
+	}
+	if !last.Done || last.Rows != 3 {
+		t.Errorf("unexpected last chunk: %v", last)
+	}
+}
+
+func TestExportJSONLines(t *testing.T) {
+	server := newExportServer(t)
+	stream := &exportStream{}
+	err := server.ExportPokedex(&pb.ExportRequest{
+		Format:  "jsonl",
+		Columns: []string{"name", "types", "habitat"},
+		FromId:  4,
+		ToId:    4,
+	}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"name":"Pokemon-4","types":["Fire"],"habitat":""}` + "\n"
+	if got := string(stream.data()); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestExportParquet(t *testing.T) {
+	server := newExportServer(t)
+	stream := &exportStream{}
+	err := server.ExportPokedex(&pb.ExportRequest{
+		Format:  "parquet",
+		Columns: []string{"id", "name", "types", "is_legendary"},
+		FromId:  1,
+		ToId:    3,
+	}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := stream.data()
+	file, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.NumRows() != 3 {
+		t.Errorf("got %d rows, want 3", file.NumRows())
+	}
+	var names []string
+	for _, f := range file.Schema().Fields() {
+		names = append(names, f.Name())
+	}
+	if got := strings.Join(names, ","); got != strings.Join(stream.chunks[0].Columns, ",") || got != "id,name,types,is_legendary" {
+		t.Errorf("Parquet columns %s don't match the requested order", got)
+	}
+
+	row := make(map[string]any)
+	if err := parquet.NewReader(file).Read(&row); err != nil {
+		t.Fatal(err)
+	}
+	types, _ := row["types"].([]any)
+	if row["id"] != int64(1) || row["name"] != "Pokemon-1" || row["is_legendary"] != false || len(types) != 1 || types[0] != "Grass" {
+		t.Errorf("unexpected first row: %v", row)
+	}
+}
+
+type limitedExportStream struct {
+	exportStream
+	ctx context.Context
+}
+
+func (s *limitedExportStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestExportWaitsForUpstreamTokens(t *testing.T) {
+	server := newExportServer(t)
+	// 20 fetches against a burst of 2, refilling quickly
+	limiter := &rateLimiter{
+		requests: newBucketLimiter(requestRate, requestBurst),
+		upstream: newBucketLimiter(500, 2),
+	}
+	limit, _, _ := limiter.begin("ip:10.0.0.1")
+	stream := &limitedExportStream{ctx: withCallLimit(context.Background(), limit)}
+
+	err := server.ExportPokedex(&pb.ExportRequest{Columns: []string{"id"}, FromId: 1, ToId: 10}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, limited := limit.limited(); limited {
+		t.Error("paced export shouldn't be limited")
+	}
+	if got := strings.Count(string(stream.data()), "\n"); got != 11 {
+		t.Errorf("got %d lines, want a header and 10 rows", got)
+	}
+
+	// Cancelling the export stops the wait
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.upstream = newBucketLimiter(0.001, 1)
+	limit, _, _ = limiter.begin("ip:10.0.0.1")
+	stream = &limitedExportStream{ctx: withCallLimit(ctx, limit)}
+	if err := server.ExportPokedex(&pb.ExportRequest{FromId: 20, ToId: 30}, stream); status.Code(err) != codes.Canceled {
+		t.Errorf("got %v, want Canceled", err)
+	}
+}
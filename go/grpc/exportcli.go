@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+
+	_ "grpc/consulresolver"
+	pb "grpc/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// runExport is the "export" command: it streams ExportPokedex from a
+// running server into a file, or stdout.
+//
+//	go run . export -format parquet -columns id,name,types,speed -generation 1 -o gen1.parquet
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:50051", `server address, e.g. "consul://pokemon-service"`)
+	format := flags.String("format", "csv", `"csv", "jsonl" or "parquet"`)
+	columns := flags.String("columns", "", "comma separated columns to export, all when empty")
+	types := flags.String("types", "", "comma separated types, only export Pokemon with any of them")
+	generation := flags.Int("generation", 0, "only export this generation")
+	from := flags.Int("from", 0, "first National Pokedex ID")
+	to := flags.Int("to", 0, "last National Pokedex ID")
+	output := flags.String("o", "", "output file, stdout when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := pb.NewPokemonServiceClient(conn).ExportPokedex(ctx, &pb.ExportRequest{
+		Format:     *format,
+		Columns:    splitList(*columns),
+		Types:      splitList(*types),
+		Generation: int32(*generation),
+		FromId:     int32(*from),
+		ToId:       int32(*to),
+	})
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	rows, err := copyExport(out, stream)
+	if err != nil {
+		if *output != "" {
+			os.Remove(*output)
+		}
+		return err
+	}
+	log.Printf("Exported %d Pokemon", rows)
+	return nil
+}
+
+// copyExport writes every chunk's data to w and returns the row count.
+func copyExport(w io.Writer, stream grpc.ServerStreamingClient[pb.ExportChunk]) (int32, error) {
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, errors.New("export ended early")
+		}
+		if err != nil {
+			return 0, err
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return 0, fmt.Errorf("failed to write export: %w", err)
+		}
+		if chunk.Done {
+			return chunk.Rows, nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import "context"
+
+const defaultFetchConcurrency = 8
+
+type fetchResult[T any] struct {
+	value T
+	err   error
+}
+
+// fetchOrdered fetches a Pokemon for every query with at most concurrency
+// requests in flight and calls emit with each result in query order.
+// Fetching never runs more than concurrency entries ahead of emit, so a slow
+// consumer applies backpressure instead of results piling up in memory.
+// It stops at the first error returned by emit.
+func (a *pokeAPI) fetchOrdered(ctx context.Context, queries []string, concurrency int, emit func(i int, data *PokeAPIResponse, err error) error) error {
+	fetch := func(ctx context.Context, i int) (*PokeAPIResponse, error) {
+		return a.getPokemon(ctx, queries[i])
+	}
+	return fetchOrderedFunc(ctx, len(queries), concurrency, fetch, emit)
+}
+
+// fetchOrderedFunc is fetchOrdered for any n fetches, e.g. a Pokemon
+// together with its species.
+func fetchOrderedFunc[T any](ctx context.Context, n, concurrency int, fetch func(ctx context.Context, i int) (T, error), emit func(i int, value T, err error) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pending := make(chan chan fetchResult[T], max(concurrency-1, 0))
+	go func() {
+		defer close(pending)
+		for i := 0; i < n; i++ {
+			result := make(chan fetchResult[T], 1)
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			go func() {
+				value, err := fetch(ctx, i)
+				result <- fetchResult[T]{value: value, err: err}
+			}()
+		}
+	}()
+
+	i := 0
+	for result := range pending {
+		r := <-result
+		if err := emit(i, r.value, r.err); err != nil {
+			return err
+		}
+		i++
+	}
+	return ctx.Err()
+}
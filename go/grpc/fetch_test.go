@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchOrdered(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/pokemon/"))
+		// Later IDs answer faster, so results complete out of order
+		time.Sleep(time.Duration(20-id) * time.Millisecond)
+		fmt.Fprintf(w, `{"id":%d,"name":"pokemon-%d"}`, id, id)
+	}))
+	defer upstream.Close()
+
+	api := newPokeAPI(upstream.URL)
+	queries := make([]string, 12)
+	for i := range queries {
+		queries[i] = strconv.Itoa(i + 1)
+	}
+
+	var got []int
+	err := api.fetchOrdered(context.Background(), queries, 3, func(i int, data *PokeAPIResponse, err error) error {
+		if err != nil {
+			return err
+		}
+		got = append(got, data.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, id := range got {
+		if id != i+1 {
+			t.Fatalf("results out of order: %v", got)
+		}
+	}
+	if len(got) != len(queries) {
+		t.Errorf("expected %d results, got %d", len(queries), len(got))
+	}
+	if n := maxInFlight.Load(); n > 3 {
+		t.Errorf("expected at most 3 requests in flight, saw %d", n)
+	}
+}
@@ -0,0 +1,416 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	pb "grpc/proto"
+)
+
+const (
+	bm25K1                 = 1.2
+	bm25B                  = 0.75
+	flavorIndexConcurrency = 4
+	flavorSaveInterval     = 100 // Species fetched between saves while building
+	defaultFlavorLimit     = 10
+	maxFlavorLimit         = 50
+)
+
+// Words too common in Pokedex entries to help ranking.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "has": true, "have": true,
+	"in": true, "is": true, "it": true, "its": true, "of": true, "on": true,
+	"one": true, "or": true, "that": true, "the": true, "their": true, "them": true,
+	"they": true, "this": true, "to": true, "was": true, "were": true, "which": true,
+	"who": true, "will": true, "with": true, "pokemon": true, "pokémon": true,
+}
+
+type flavorEntry struct {
+	Text     string   `json:"text"`
+	Versions []string `json:"versions"`
+}
+
+// flavorDoc holds one species' deduplicated English Pokedex entries.
+type flavorDoc struct {
+	ID      int           `json:"id"`
+	Name    string        `json:"name"`
+	Entries []flavorEntry `json:"entries"`
+}
+
+// flavorIndex is an in-memory inverted index over Pokedex flavor text,
+// ranked with BM25. The documents are saved to path so restarts don't have
+// to fetch every species again.
+type flavorIndex struct {
+	path string
+
+	mu       sync.RWMutex
+	ready    bool
+	loaded   int
+	docs     map[int]*flavorDoc
+	postings map[string]map[int]int // term -> species ID -> term frequency
+	lengths  map[int]int
+	totalLen int
+}
+
+func newFlavorIndex(path string) *flavorIndex {
+	return &flavorIndex{
+		path:     path,
+		docs:     make(map[int]*flavorDoc),
+		postings: make(map[string]map[int]int),
+		lengths:  make(map[int]int),
+	}
+}
+
+// build loads the saved documents and fetches any species they're missing
+// from PokeAPI, saving progress as it goes so a restart resumes where it
+// stopped. Upstream fetches go through the call limit in ctx. Searches are
+// refused until it finishes.
+func (x *flavorIndex) build(ctx context.Context, api *pokeAPI) error {
+	docs, err := loadFlavorDocs(x.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("Failed to load flavor text index, rebuilding: %v", err)
+	}
+
+	if missing := missingFlavorDocs(docs); len(missing) > 0 {
+		docs = x.fetch(ctx, api, docs, missing)
+	} else {
+		x.mu.Lock()
+		x.loaded = len(docs)
+		x.mu.Unlock()
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, doc := range docs {
+		x.add(doc)
+	}
+	x.ready = true
+
+	log.Printf("Flavor text index ready with %d species", len(x.docs))
+	return ctx.Err()
+}
+
+// missingFlavorDocs lists the species IDs docs doesn't have yet.
+func missingFlavorDocs(docs []*flavorDoc) []int {
+	have := make(map[int]bool, len(docs))
+	for _, doc := range docs {
+		have[doc.ID] = true
+	}
+	var missing []int
+	for id := 1; id <= maxPokedexID; id++ {
+		if !have[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// fetch downloads the missing species and adds them to docs. Progress is
+// saved every flavorSaveInterval species and when it stops, even if some
+// failed or ctx was cancelled.
+func (x *flavorIndex) fetch(ctx context.Context, api *pokeAPI, docs []*flavorDoc, missing []int) []*flavorDoc {
+	if len(docs) > 0 {
+		log.Printf("Resuming flavor text index with %d species left to fetch", len(missing))
+	} else {
+		log.Printf("Building flavor text index from PokeAPI")
+	}
+	x.mu.Lock()
+	x.loaded = len(docs)
+	x.mu.Unlock()
+
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for _, id := range missing {
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	fetched := 0
+	save := func() {
+		if err := saveFlavorDocs(x.path, docs); err != nil {
+			log.Printf("Failed to save flavor text index: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < flavorIndexConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				data, err := api.getSpecies(ctx, strconv.Itoa(id))
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Printf("Failed to fetch species %d: %v", id, err)
+					}
+					continue
+				}
+
+				mu.Lock()
+				docs = append(docs, flavorDocFromSpecies(data))
+				if fetched++; fetched%flavorSaveInterval == 0 {
+					save()
+				}
+				mu.Unlock()
+
+				x.mu.Lock()
+				x.loaded++
+				x.mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if fetched%flavorSaveInterval != 0 {
+		save()
+	}
+	return docs
+}
+
+func flavorDocFromSpecies(data *PokeAPISpecies) *flavorDoc {
+	doc := &flavorDoc{ID: data.ID, Name: strings.Title(data.Name)}
+	seen := make(map[string]int)
+	for _, e := range data.FlavorTextEntries {
+		if e.Language.Name != "en" {
+			continue
+		}
+		text := cleanFlavorText(e.FlavorText)
+		key := strings.ToLower(text)
+		if i, ok := seen[key]; ok {
+			doc.Entries[i].Versions = append(doc.Entries[i].Versions, e.Version.Name)
+			continue
+		}
+		seen[key] = len(doc.Entries)
+		doc.Entries = append(doc.Entries, flavorEntry{Text: text, Versions: []string{e.Version.Name}})
+	}
+	return doc
+}
+
+func loadFlavorDocs(path string) ([]*flavorDoc, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var docs []*flavorDoc
+	if err := json.Unmarshal(data, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func saveFlavorDocs(path string, docs []*flavorDoc) error {
+	data, err := json.Marshal(docs)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data)
+}
+
+// add indexes a document. The caller must hold x.mu.
+func (x *flavorIndex) add(doc *flavorDoc) {
+	x.docs[doc.ID] = doc
+	for _, entry := range doc.Entries {
+		for _, t := range tokenize(entry.Text) {
+			if x.postings[t.term] == nil {
+				x.postings[t.term] = make(map[int]int)
+			}
+			x.postings[t.term][doc.ID]++
+			x.lengths[doc.ID]++
+			x.totalLen++
+		}
+	}
+}
+
+type flavorToken struct {
+	term       string
+	start, end int // Character offsets, end exclusive
+}
+
+// tokenize splits text into stemmed, lowercase words, skipping stop words.
+func tokenize(text string) []flavorToken {
+	var tokens []flavorToken
+	var word []rune
+	start := 0
+	pos := 0
+
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		w := strings.ToLower(string(word))
+		if !stopWords[w] {
+			tokens = append(tokens, flavorToken{term: stem(w), start: start, end: pos})
+		}
+		word = word[:0]
+	}
+
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if len(word) == 0 {
+				start = pos
+			}
+			word = append(word, r)
+		} else {
+			flush()
+		}
+		pos++
+	}
+	flush()
+	return tokens
+}
+
+var errNoSearchTerms = errors.New("no searchable words")
+
+// search ranks species against query with BM25.
+func (x *flavorIndex) search(query string, limit int) ([]*pb.FlavorTextHit, error) {
+	terms := make(map[string]bool)
+	for _, t := range tokenize(query) {
+		terms[t.term] = true
+	}
+	if len(terms) == 0 {
+		return nil, errNoSearchTerms
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	n := float64(len(x.docs))
+	avgLen := float64(x.totalLen) / math.Max(n, 1)
+
+	scores := make(map[int]float64)
+	for term := range terms {
+		docs := x.postings[term]
+		df := float64(len(docs))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range docs {
+			f := float64(tf)
+			norm := 1 - bm25B + bm25B*float64(x.lengths[id])/avgLen
+			scores[id] += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+	}
+
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	hits := make([]*pb.FlavorTextHit, len(ids))
+	for i, id := range ids {
+		doc := x.docs[id]
+		entry, highlights := bestEntry(doc, terms)
+		hits[i] = &pb.FlavorTextHit{
+			Id:         int32(id),
+			Name:       doc.Name,
+			Score:      math.Round(scores[id]*1000) / 1000,
+			Snippet:    entry.Text,
+			Highlights: highlights,
+			Versions:   entry.Versions,
+		}
+	}
+	return hits, nil
+}
+
+// bestEntry picks the entry matching the most distinct query terms and
+// returns where they occur in it.
+func bestEntry(doc *flavorDoc, terms map[string]bool) (flavorEntry, []*pb.Highlight) {
+	var best flavorEntry
+	var bestHighlights []*pb.Highlight
+	bestMatched := -1
+
+	for _, entry := range doc.Entries {
+		matched := make(map[string]bool)
+		var highlights []*pb.Highlight
+		for _, t := range tokenize(entry.Text) {
+			if terms[t.term] {
+				matched[t.term] = true
+				highlights = append(highlights, &pb.Highlight{Start: int32(t.start), End: int32(t.end)})
+			}
+		}
+		if len(matched) > bestMatched {
+			best, bestHighlights, bestMatched = entry, highlights, len(matched)
+		}
+	}
+	return best, bestHighlights
+}
+
+// status reports whether the index is ready and how many species it has loaded.
+func (x *flavorIndex) status() (bool, int) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.ready, x.loaded
+}
+
+func (s *pokemonServer) SearchFlavorText(ctx context.Context, req *pb.FlavorTextSearchRequest) (*pb.FlavorTextSearchResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return &pb.FlavorTextSearchResponse{
+			Success: false,
+			Message: "Please describe the Pokemon you're looking for",
+		}, nil
+	}
+
+	if ready, loaded := s.flavor.status(); !ready {
+		return &pb.FlavorTextSearchResponse{
+			Success: false,
+			Message: fmt.Sprintf("Pokedex search is still warming up (%d/%d species), try again shortly", loaded, maxPokedexID),
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultFlavorLimit
+	}
+	limit = min(limit, maxFlavorLimit)
+
+	log.Printf("Searching flavor text: %q", query)
+
+	hits, err := s.flavor.search(query, limit)
+	if err != nil {
+		return &pb.FlavorTextSearchResponse{
+			Success: false,
+			Message: "Please use more specific words",
+		}, nil
+	}
+
+	if len(hits) == 0 {
+		return &pb.FlavorTextSearchResponse{
+			Success: false,
+			Message: "No Pokemon matched your description",
+		}, nil
+	}
+
+	// Only the best match counts as the Pokemon the player was after
+	s.trends.record(hits[0].Id, hits[0].Name)
+
+	return &pb.FlavorTextSearchResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d Pokemon", len(hits)),
+		Hits:    hits,
+	}, nil
+}
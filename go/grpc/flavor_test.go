@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"sleeps":          "sleep",
+		"sleeping":        "sleep",
+		"stores":          "store",
+		"storing":         "store",
+		"electricity":     "electr",
+		"electric":        "electr",
+		"cheeks":          "cheek",
+		"hopping":         "hop",
+		"generalizations": "gener",
+	}
+	for word, want := range cases {
+		if got := stem(word); got != want {
+			t.Errorf("stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestFlavorIndexSearch(t *testing.T) {
+	x := newFlavorIndex("")
+	x.add(&flavorDoc{ID: 25, Name: "Pikachu", Entries: []flavorEntry{
+		{Text: "It stores electricity in the electric sacs on its cheeks.", Versions: []string{"red"}},
+	}})
+	x.add(&flavorDoc{ID: 143, Name: "Snorlax", Entries: []flavorEntry{
+		{Text: "Very lazy. Just eats and sleeps.", Versions: []string{"red"}},
+		{Text: "It is not satisfied unless it eats over 880 pounds of food every day. When it is done eating, it goes promptly to sleep.", Versions: []string{"gold"}},
+	}})
+
+	hits, err := x.search("the one that sleeps all day", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Name != "Snorlax" {
+		t.Fatalf("expected Snorlax, got %v", hits)
+	}
+	if hits[0].Versions[0] != "gold" {
+		t.Errorf("expected the entry matching both words, got %q", hits[0].Snippet)
+	}
+
+	hits, _ = x.search("stores electricity in its cheeks", 10)
+	if len(hits) != 1 || hits[0].Name != "Pikachu" {
+		t.Fatalf("expected Pikachu, got %v", hits)
+	}
+	h := hits[0].Highlights[0]
+	if got := []rune(hits[0].Snippet)[h.Start:h.End]; string(got) != "stores" {
+		t.Errorf("expected first highlight on %q, got %q", "stores", string(got))
+	}
+
+	if _, err := x.search("the of and", 10); err != errNoSearchTerms {
+		t.Errorf("expected errNoSearchTerms, got %v", err)
+	}
+}
+
+// speciesServer serves flavor text for every species except failing.
+func speciesServer(t *testing.T, failing int) (*pokeAPI, *atomic.Int64) {
+	var fetches atomic.Int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		var id int
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/pokemon-species/"), "%d", &id)
+		if id == failing {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%d,"name":"species-%d","flavor_text_entries":[
+			{"flavor_text":"Entry %d","language":{"name":"en"},"version":{"name":"red"}}]}`, id, id, id)
+	}))
+	t.Cleanup(upstream.Close)
+	return newPokeAPI(upstream.URL), &fetches
+}
+
+func TestFlavorIndexBuildResumes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flavor_index.json")
+	var saved []*flavorDoc
+	for id := 1; id <= maxPokedexID-25; id++ {
+		saved = append(saved, &flavorDoc{ID: id, Name: fmt.Sprintf("Species-%d", id)})
+	}
+	if err := saveFlavorDocs(path, saved); err != nil {
+		t.Fatal(err)
+	}
+	limiter := &rateLimiter{
+		requests: newBucketLimiter(requestRate, requestBurst),
+		upstream: newBucketLimiter(500, 2),
+	}
+	ctx := withCallLimit(context.Background(), limiter.background("flavor-index"))
+
+	// Only the missing species are fetched, and a failed one is left for
+	// the next start
+	api, fetches := speciesServer(t, maxPokedexID-10)
+	x := newFlavorIndex(path)
+	if err := x.build(ctx, api); err != nil {
+		t.Fatal(err)
+	}
+	if got := fetches.Load(); got != 25 {
+		t.Errorf("fetched %d species, want 25", got)
+	}
+	if ready, loaded := x.status(); !ready || loaded != maxPokedexID-1 {
+		t.Errorf("ready %v with %d species", ready, loaded)
+	}
+	docs, err := loadFlavorDocs(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing := missingFlavorDocs(docs); len(missing) != 1 || missing[0] != maxPokedexID-10 {
+		t.Errorf("saved index is missing %v", missing)
+	}
+
+	api, fetches = speciesServer(t, 0)
+	if err := newFlavorIndex(path).build(ctx, api); err != nil {
+		t.Fatal(err)
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("fetched %d species on restart, want 1", got)
+	}
+}
+
+func TestFlavorIndexBuildWaitsForUpstreamTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flavor_index.json")
+	// One token, then none for the rest of the test
+	limiter := &rateLimiter{
+		requests: newBucketLimiter(requestRate, requestBurst),
+		upstream: newBucketLimiter(0.001, 1),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	ctx = withCallLimit(ctx, limiter.background("flavor-index"))
+
+	api, fetches := speciesServer(t, 0)
+	if err := newFlavorIndex(path).build(ctx, api); err != context.DeadlineExceeded {
+		t.Errorf("got %v, want the build to stop at the deadline", err)
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("fetched %d species, want 1", got)
+	}
+
+	// What was fetched is saved for the next start
+	docs, err := loadFlavorDocs(path)
+	if err != nil || len(docs) != 1 {
+		t.Errorf("saved %d species: %v", len(docs), err)
+	}
+}
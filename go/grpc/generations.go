@@ -0,0 +1,35 @@
+package main
+
+// Last National Pokedex number introduced in each generation.
+var generationEnds = []int{151, 251, 386, 493, 649, 721, 809, 905, 1025}
+
+var romanNumerals = []string{"I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX"}
+
+const maxPokedexID = 1025
+
+// generationRange returns the National Pokedex IDs covered by generations
+// min through max (1-based, inclusive).
+func generationRange(min, max int) (int, int) {
+	first := 1
+	if min > 1 {
+		first = generationEnds[min-2] + 1
+	}
+	return first, generationEnds[max-1]
+}
+
+// generationOf returns the generation that introduced a Pokedex ID, or 0.
+func generationOf(id int) int {
+	for i, end := range generationEnds {
+		if id <= end {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func generationName(gen int) string {
+	if gen < 1 || gen > len(romanNumerals) {
+		return "Unknown"
+	}
+	return "Generation " + romanNumerals[gen-1]
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+const (
+	itemSpriteURL          = "https://raw.githubusercontent.com/PokeAPI/sprites/master/sprites/items/%s.png"
+	defaultItemSearchLimit = 20
+	maxItemSearchLimit     = 100
+)
+
+// PokeAPI item response
+type PokeAPIItem struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Cost        int    `json:"cost"`
+	FlingPower  *int   `json:"fling_power"`
+	FlingEffect *struct {
+		Name string `json:"name"`
+	} `json:"fling_effect"`
+	Category struct {
+		Name string `json:"name"`
+	} `json:"category"`
+	EffectEntries []struct {
+		Effect      string `json:"effect"`
+		ShortEffect string `json:"short_effect"`
+		Language    struct {
+			Name string `json:"name"`
+		} `json:"language"`
+	} `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		Text     string `json:"text"`
+		Language struct {
+			Name string `json:"name"`
+		} `json:"language"`
+	} `json:"flavor_text_entries"`
+	Sprites struct {
+		Default string `json:"default"`
+	} `json:"sprites"`
+	HeldByPokemon []struct {
+		Pokemon struct {
+			Name string `json:"name"`
+		} `json:"pokemon"`
+		VersionDetails []struct {
+			Rarity  int `json:"rarity"`
+			Version struct {
+				Name string `json:"name"`
+			} `json:"version"`
+		} `json:"version_details"`
+	} `json:"held_by_pokemon"`
+}
+
+// PokeAPI berry response
+type PokeAPIBerry struct {
+	GrowthTime       int `json:"growth_time"`
+	MaxHarvest       int `json:"max_harvest"`
+	NaturalGiftPower int `json:"natural_gift_power"`
+	Size             int `json:"size"`
+	Smoothness       int `json:"smoothness"`
+	SoilDryness      int `json:"soil_dryness"`
+	Firmness         struct {
+		Name string `json:"name"`
+	} `json:"firmness"`
+	NaturalGiftType struct {
+		Name string `json:"name"`
+	} `json:"natural_gift_type"`
+	Flavors []struct {
+		Potency int `json:"potency"`
+		Flavor  struct {
+			Name string `json:"name"`
+		} `json:"flavor"`
+	} `json:"flavors"`
+}
+
+// PokeAPI list of named resources, e.g. "item?limit=100000"
+type namedResourceList struct {
+	Results []namedResource `json:"results"`
+}
+
+type namedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// PokeAPI item category and pocket responses
+type PokeAPIItemCategory struct {
+	Name  string          `json:"name"`
+	Items []namedResource `json:"items"`
+}
+
+type PokeAPIItemPocket struct {
+	Name       string          `json:"name"`
+	Categories []namedResource `json:"categories"`
+}
+
+// getItem fetches an item by ID or name. Berries can be looked up without
+// their "-berry" suffix, e.g. "oran".
+func (a *pokeAPI) getItem(ctx context.Context, query string) (*PokeAPIItem, error) {
+	var data PokeAPIItem
+	err := a.get(ctx, "item/"+query, &data)
+	if errors.Is(err, errNotFound) && !strings.HasSuffix(query, "-berry") {
+		if _, convErr := strconv.Atoi(query); convErr != nil {
+			err = a.get(ctx, "item/"+query+"-berry", &data)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// getBerry fetches a berry by name without the "-berry" suffix.
+func (a *pokeAPI) getBerry(ctx context.Context, name string) (*PokeAPIBerry, error) {
+	var data PokeAPIBerry
+	if err := a.get(ctx, "berry/"+name, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// categoryItems lists the items in an item category, or in every category
+// of an item pocket like "berries" or "medicine".
+func (a *pokeAPI) categoryItems(ctx context.Context, name string) ([]namedResource, error) {
+	var category PokeAPIItemCategory
+	err := a.get(ctx, "item-category/"+name, &category)
+	if err == nil {
+		return category.Items, nil
+	}
+	if !errors.Is(err, errNotFound) {
+		return nil, err
+	}
+
+	var pocket PokeAPIItemPocket
+	if pocketErr := a.get(ctx, "item-pocket/"+name, &pocket); pocketErr != nil {
+		if errors.Is(pocketErr, errNotFound) {
+			return nil, err
+		}
+		return nil, pocketErr
+	}
+	var items []namedResource
+	for _, c := range pocket.Categories {
+		if err := a.get(ctx, "item-category/"+c.Name, &category); err != nil {
+			return nil, err
+		}
+		items = append(items, category.Items...)
+	}
+	return items, nil
+}
+
+func toItem(data *PokeAPIItem, berry *PokeAPIBerry) *pb.Item {
+	item := &pb.Item{
+		Id:       int32(data.ID),
+		Name:     displayName(data.Name),
+		Category: data.Category.Name,
+		Cost:     int32(data.Cost),
+		Sprite:   data.Sprites.Default,
+	}
+	if data.FlingPower != nil {
+		item.FlingPower = int32(*data.FlingPower)
+	}
+	if data.FlingEffect != nil {
+		item.FlingEffect = data.FlingEffect.Name
+	}
+	for _, e := range data.EffectEntries {
+		if e.Language.Name == "en" {
+			item.Effect = cleanFlavorText(e.Effect)
+			item.ShortEffect = cleanFlavorText(e.ShortEffect)
+			break
+		}
+	}
+	// Entries run oldest game first
+	for _, e := range data.FlavorTextEntries {
+		if e.Language.Name == "en" {
+			item.Description = cleanFlavorText(e.Text)
+		}
+	}
+
+	for _, h := range data.HeldByPokemon {
+		holder := &pb.ItemHolder{Pokemon: strings.Title(h.Pokemon.Name)}
+		for _, v := range h.VersionDetails {
+			holder.Versions = append(holder.Versions, &pb.ItemRarity{
+				Version: v.Version.Name,
+				Rarity:  int32(v.Rarity),
+			})
+		}
+		item.HeldBy = append(item.HeldBy, holder)
+	}
+
+	if berry != nil {
+		item.Berry = &pb.Berry{
+			Firmness:         berry.Firmness.Name,
+			GrowthTime:       int32(berry.GrowthTime),
+			MaxHarvest:       int32(berry.MaxHarvest),
+			NaturalGiftPower: int32(berry.NaturalGiftPower),
+			NaturalGiftType:  strings.Title(berry.NaturalGiftType.Name),
+			Size:             int32(berry.Size),
+			Smoothness:       int32(berry.Smoothness),
+			SoilDryness:      int32(berry.SoilDryness),
+		}
+		for _, f := range berry.Flavors {
+			if f.Potency > 0 {
+				item.Berry.Flavors = append(item.Berry.Flavors, &pb.BerryFlavor{
+					Flavor:  f.Flavor.Name,
+					Potency: int32(f.Potency),
+				})
+			}
+		}
+	}
+	return item
+}
+
+func toItemSummary(r namedResource) *pb.ItemSummary {
+	id, _ := strconv.Atoi(path.Base(r.URL))
+	return &pb.ItemSummary{
+		Id:     int32(id),
+		Name:   displayName(r.Name),
+		Sprite: fmt.Sprintf(itemSpriteURL, r.Name),
+	}
+}
+
+// itemSlug maps an item name the way players type it to PokeAPI's slug,
+// e.g. "King's Rock" -> "kings-rock".
+func itemSlug(query string) string {
+	return strings.Join(nameWords(query), "-")
+}
+
+func itemFetchError(err error) string {
+	if errors.Is(err, errNotFound) {
+		return "Item not found. Try a different name or ID"
+	}
+	return fmt.Sprintf("Failed to fetch item: %v", err)
+}
+
+// matchItems ranks item names containing query: exact matches first, then
+// names starting with it, then the rest, alphabetically within each.
+func matchItems(items []namedResource, query string) []namedResource {
+	rank := func(name string) int {
+		switch {
+		case name == query:
+			return 0
+		case strings.HasPrefix(name, query):
+			return 1
+		}
+		return 2
+	}
+
+	var matches []namedResource
+	for _, item := range items {
+		if strings.Contains(item.Name, query) {
+			matches = append(matches, item)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		ri, rj := rank(matches[i].Name), rank(matches[j].Name)
+		if ri != rj {
+			return ri < rj
+		}
+		return matches[i].Name < matches[j].Name
+	})
+	return matches
+}
+
+type itemServer struct {
+	pb.UnimplementedItemServiceServer
+	api *pokeAPI
+}
+
+func (s *itemServer) GetItem(ctx context.Context, req *pb.ItemRequest) (*pb.ItemResponse, error) {
+	query := itemSlug(req.Query)
+
+	if query == "" {
+		return &pb.ItemResponse{
+			Success: false,
+			Message: "Please enter an item name or ID",
+		}, nil
+	}
+
+	log.Printf("Fetching item: %s", query)
+
+	data, err := s.api.getItem(ctx, query)
+	if err != nil {
+		return &pb.ItemResponse{
+			Success: false,
+			Message: itemFetchError(err),
+		}, nil
+	}
+
+	var berry *PokeAPIBerry
+	if name, ok := strings.CutSuffix(data.Name, "-berry"); ok {
+		berry, err = s.api.getBerry(ctx, name)
+		if err != nil && !errors.Is(err, errNotFound) {
+			return &pb.ItemResponse{
+				Success: false,
+				Message: itemFetchError(err),
+			}, nil
+		}
+	}
+
+	return &pb.ItemResponse{
+		Success: true,
+		Message: "Item found!",
+		Item:    toItem(data, berry),
+	}, nil
+}
+
+func (s *itemServer) SearchItems(ctx context.Context, req *pb.ItemSearchRequest) (*pb.ItemSearchResponse, error) {
+	query := itemSlug(req.Query)
+
+	if query == "" {
+		return &pb.ItemSearchResponse{
+			Success: false,
+			Message: "Please enter part of an item name",
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultItemSearchLimit
+	}
+	limit = min(limit, maxItemSearchLimit)
+
+	log.Printf("Searching items: %s", query)
+
+	var list namedResourceList
+	if err := s.api.get(ctx, "item?limit=100000", &list); err != nil {
+		return &pb.ItemSearchResponse{
+			Success: false,
+			Message: itemFetchError(err),
+		}, nil
+	}
+
+	matches := matchItems(list.Results, query)
+	if len(matches) == 0 {
+		names := make([]string, len(list.Results))
+		for i, r := range list.Results {
+			names[i] = r.Name
+		}
+		message := "No items matched your search"
+		if suggestions := closestNames(query, names, maxSuggestions); len(suggestions) > 0 {
+			message = fmt.Sprintf("No items matched. Did you mean %s?", strings.Join(suggestions, ", "))
+		}
+		return &pb.ItemSearchResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	total := len(matches)
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	items := make([]*pb.ItemSummary, len(matches))
+	for i, m := range matches {
+		items[i] = toItemSummary(m)
+	}
+
+	return &pb.ItemSearchResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d items", total),
+		Items:   items,
+		Total:   int32(total),
+	}, nil
+}
+
+func (s *itemServer) ListItems(ctx context.Context, req *pb.ItemCategoryRequest) (*pb.ItemListResponse, error) {
+	category := itemSlug(req.Category)
+
+	if category == "" {
+		return &pb.ItemListResponse{
+			Success: false,
+			Message: "Please enter an item category or pocket",
+		}, nil
+	}
+
+	log.Printf("Listing items: %s", category)
+
+	resources, err := s.api.categoryItems(ctx, category)
+	if err != nil {
+		message := fmt.Sprintf("Failed to fetch items: %v", err)
+		if errors.Is(err, errNotFound) {
+			message = "Category not found. Try e.g. \"berries\", \"medicine\" or \"standard-balls\""
+		}
+		return &pb.ItemListResponse{
+			Success: false,
+			Message: message,
+		}, nil
+	}
+
+	items := make([]*pb.ItemSummary, len(resources))
+	for i, r := range resources {
+		items[i] = toItemSummary(r)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+
+	return &pb.ItemListResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d items", len(items)),
+		Items:   items,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatchItems(t *testing.T) {
+	items := []namedResource{
+		{Name: "ultra-ball"}, {Name: "poke-ball"}, {Name: "great-ball"},
+		{Name: "ball"}, {Name: "potion"}, {Name: "ball-a"},
+	}
+
+	var got []string
+	for _, m := range matchItems(items, "ball") {
+		got = append(got, m.Name)
+	}
+	want := []string{"ball", "ball-a", "great-ball", "poke-ball", "ultra-ball"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestToItem(t *testing.T) {
+	var data PokeAPIItem
+	err := json.Unmarshal([]byte(`{
+		"id": 132, "name": "oran-berry", "cost": 20, "fling_power": 10, "fling_effect": null,
+		"category": {"name": "medicine"},
+		"effect_entries": [{"effect": "Restores 10 HP.", "short_effect": "Restores 10 HP.", "language": {"name": "en"}}],
+		"flavor_text_entries": [
+			{"text": "Restores\nHP.", "language": {"name": "en"}},
+			{"text": "A Berry to be\nconsumed.", "language": {"name": "en"}}
+		],
+		"sprites": {"default": "oran-berry.png"},
+		"held_by_pokemon": [{"pokemon": {"name": "pikachu"}, "version_details": [{"rarity": 5, "version": {"name": "ruby"}}]}]
+	}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	berry := &PokeAPIBerry{NaturalGiftPower: 60}
+	berry.NaturalGiftType.Name = "poison"
+
+	item := toItem(&data, berry)
+	if item.Name != "Oran Berry" || item.FlingPower != 10 || item.FlingEffect != "" {
+		t.Errorf("unexpected item: %v", item)
+	}
+	if item.Description != "A Berry to be consumed." {
+		t.Errorf("expected the latest description, got %q", item.Description)
+	}
+	if len(item.HeldBy) != 1 || item.HeldBy[0].Pokemon != "Pikachu" || item.HeldBy[0].Versions[0].Rarity != 5 {
+		t.Errorf("unexpected holders: %v", item.HeldBy)
+	}
+	if item.Berry == nil || item.Berry.NaturalGiftType != "Poison" || item.Berry.NaturalGiftPower != 60 {
+		t.Errorf("unexpected berry: %v", item.Berry)
+	}
+}
@@ -2,46 +2,33 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net"
 	"net/http"
-	"strings"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 
 	pb "grpc/proto"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type pokemonServer struct {
 	pb.UnimplementedPokemonServiceServer
-}
-
-// PokeAPI response structures
-type PokeAPIResponse struct {
-	ID     int    `json:"id"`
-	Name   string `json:"name"`
-	Height int    `json:"height"`
-	Weight int    `json:"weight"`
-	Types  []struct {
-		Type struct {
-			Name string `json:"name"`
-		} `json:"type"`
-	} `json:"types"`
-	Sprites struct {
-		FrontDefault string `json:"front_default"`
-		Other        struct {
-			OfficialArtwork struct {
-				FrontDefault string `json:"front_default"`
-			} `json:"official-artwork"`
-		} `json:"other"`
-	} `json:"sprites"`
+	api    *pokeAPI
+	quiz   *quizManager
+	flavor *flavorIndex
+	trends *trendStore
 }
 
 func (s *pokemonServer) GetPokemon(ctx context.Context, req *pb.PokemonRequest) (*pb.PokemonResponse, error) {
-	query := strings.TrimSpace(strings.ToLower(req.Query))
+	query := normalizeQuery(req.Query)
 
 	if query == "" {
 		return &pb.PokemonResponse{
@@ -53,66 +40,13 @@ func (s *pokemonServer) GetPokemon(ctx context.Context, req *pb.PokemonRequest)
 	log.Printf("Fetching Pokemon: %s", query)
 
 	// Call PokeAPI
-	url := fmt.Sprintf("https://pokeapi.co/api/v2/pokemon/%s", query)
-	resp, err := http.Get(url)
+	pokeData, err := s.api.getPokemonForm(ctx, query, req.Form)
 	if err != nil {
-		return &pb.PokemonResponse{
-			Success: false,
-			Message: fmt.Sprintf("Failed to fetch Pokemon: %v", err),
-		}, nil
+		return s.api.notFoundResponse(ctx, query, err), nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		return &pb.PokemonResponse{
-			Success: false,
-			Message: "Pokemon not found. Try a different name or ID (1-1025)",
-		}, nil
-	}
-
-	if resp.StatusCode != 200 {
-		return &pb.PokemonResponse{
-			Success: false,
-			Message: fmt.Sprintf("API error: status code %d", resp.StatusCode),
-		}, nil
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return &pb.PokemonResponse{
-			Success: false,
-			Message: "Failed to read API response",
-		}, nil
-	}
-
-	var pokeData PokeAPIResponse
-	if err := json.Unmarshal(body, &pokeData); err != nil {
-		return &pb.PokemonResponse{
-			Success: false,
-			Message: "Failed to parse Pokemon data",
-		}, nil
-	}
-
-	// Extract types
-	types := make([]string, len(pokeData.Types))
-	for i, t := range pokeData.Types {
-		types[i] = strings.Title(t.Type.Name)
-	}
-
-	// Prefer official artwork, fallback to sprite
-	imageURL := pokeData.Sprites.Other.OfficialArtwork.FrontDefault
-	if imageURL == "" {
-		imageURL = pokeData.Sprites.FrontDefault
-	}
-
-	pokemon := &pb.Pokemon{
-		Id:       int32(pokeData.ID),
-		Name:     strings.Title(pokeData.Name),
-		Types:    types,
-		ImageUrl: imageURL,
-		Height:   int32(pokeData.Height),
-		Weight:   int32(pokeData.Weight),
-	}
+	pokemon := toPokemon(pokeData)
+	s.trends.record(pokemon.Id, pokemon.Name)
 
 	log.Printf("Successfully fetched: %s (ID: %d)", pokemon.Name, pokemon.Id)
 
@@ -129,19 +63,119 @@ func (s *pokemonServer) SearchPokemon(ctx context.Context, req *pb.SearchRequest
 }
 
 func main() {
-	port := 50051
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			log.Fatalf("Export failed: %v", err)
+		}
+		return
+	}
+
+	port := getPortFromEnv("SERVICE_PORT", 50051)
+	webPort := getPortFromEnv("WEB_PORT", 8080)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterPokemonServiceServer(grpcServer, &pokemonServer{})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := &pokemonServer{
+		api:    newPokeAPI(pokeAPIBaseURL),
+		quiz:   newQuizManager(),
+		flavor: newFlavorIndex(getEnv("FLAVOR_INDEX_PATH", "flavor_index.json")),
+		trends: newTrendStore(getEnv("TRENDING_PATH", "trending.json")),
+	}
+
+	if err := server.trends.load(); err != nil {
+		log.Printf("Failed to load trending counts, starting fresh: %v", err)
+	}
+	trendsSaved := make(chan struct{})
+	go func() {
+		defer close(trendsSaved)
+		server.trends.run(ctx)
+	}()
+
+	limiter := newRateLimiter()
+
+	// Index Pokedex entries in the background, search is refused until it's
+	// ready. Fetches share PokeAPI's fair use limit with client calls.
+	go func() {
+		indexCtx := withCallLimit(ctx, limiter.background("flavor-index"))
+		if err := server.flavor.build(indexCtx, server.api); err != nil {
+			log.Printf("Failed to build flavor text index: %v", err)
+		}
+	}()
+
+	items := &itemServer{api: server.api}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(limiter.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(limiter.StreamServerInterceptor),
+	)
+	pb.RegisterPokemonServiceServer(grpcServer, server)
+	pb.RegisterItemServiceServer(grpcServer, items)
+	pb.RegisterAdminServiceServer(grpcServer, &adminServer{
+		api:   server.api,
+		token: os.Getenv("ADMIN_TOKEN"),
+	})
+
+	// Consul checks the standard gRPC health service
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	// Browsers can't speak native gRPC, so serve gRPC-Web and Connect over plain HTTP
+	webServer := &http.Server{
+		Addr:      fmt.Sprintf(":%d", webPort),
+		Handler:   newWebHandler(server, items, limiter, splitList(getEnv("CORS_ALLOWED_ORIGINS", "*"))),
+		Protocols: new(http.Protocols),
+	}
+	webServer.Protocols.SetHTTP1(true)
+	webServer.Protocols.SetUnencryptedHTTP2(true)
+
+	go func() {
+		log.Printf("gRPC-Web and Connect server listening on port %d", webPort)
+		if err := webServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to serve web: %v", err)
+		}
+	}()
+
+	registration, err := registerService(port)
+	if err != nil {
+		log.Fatalf("Failed to register service: %v", err)
+	}
 
 	log.Printf("Pokemon gRPC Server listening on port %d", port)
 	log.Printf("Ready to fetch Pokemon data from PokeAPI!")
 
+	// Leave discovery first, then finish in-flight calls and save state before exiting
+	go func() {
+		<-ctx.Done()
+		log.Printf("Shutting down")
+		healthServer.Shutdown()
+		registration.deregister()
+		webServer.Shutdown(context.Background())
+		grpcServer.GracefulStop()
+	}()
+
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	<-trendsSaved
+}
+
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func getPortFromEnv(key string, defaultValue int) int {
+	port, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || port <= 0 {
+		return defaultValue
+	}
+	return port
 }
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+// PokeAPI move response
+type PokeAPIMove struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Accuracy     *int   `json:"accuracy"`
+	Power        *int   `json:"power"`
+	PP           int    `json:"pp"`
+	Priority     int    `json:"priority"`
+	EffectChance *int   `json:"effect_chance"`
+	Type         struct {
+		Name string `json:"name"`
+	} `json:"type"`
+	DamageClass struct {
+		Name string `json:"name"`
+	} `json:"damage_class"`
+	EffectEntries []struct {
+		ShortEffect string `json:"short_effect"`
+		Language    struct {
+			Name string `json:"name"`
+		} `json:"language"`
+	} `json:"effect_entries"`
+}
+
+// getMove fetches a move by ID or name.
+func (a *pokeAPI) getMove(ctx context.Context, query string) (*PokeAPIMove, error) {
+	var data PokeAPIMove
+	if err := a.get(ctx, "move/"+query, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func toMove(data *PokeAPIMove) *pb.Move {
+	move := &pb.Move{
+		Id:       int32(data.ID),
+		Name:     displayName(data.Name),
+		Type:     strings.Title(data.Type.Name),
+		Pp:       int32(data.PP),
+		Priority: int32(data.Priority),
+		Category: data.DamageClass.Name,
+	}
+	if data.Power != nil {
+		move.Power = int32(*data.Power)
+	}
+	if data.Accuracy != nil {
+		move.Accuracy = int32(*data.Accuracy)
+	}
+	for _, e := range data.EffectEntries {
+		if e.Language.Name == "en" {
+			move.Effect = e.ShortEffect
+			if data.EffectChance != nil {
+				move.Effect = strings.ReplaceAll(move.Effect, "$effect_chance", strconv.Itoa(*data.EffectChance))
+			}
+			break
+		}
+	}
+	return move
+}
+
+// slug turns a display name like "Thunder Punch" into "thunder-punch".
+func slug(name string) string {
+	return strings.Join(strings.Fields(normalizeQuery(name)), "-")
+}
+
+// displayName turns a slug like "thunder-punch" into "Thunder Punch".
+func displayName(slug string) string {
+	return strings.Title(strings.ReplaceAll(slug, "-", " "))
+}
+
+func moveFetchError(err error) string {
+	if errors.Is(err, errNotFound) {
+		return "Move not found. Try a different name or ID"
+	}
+	return fmt.Sprintf("Failed to fetch move: %v", err)
+}
+
+func (s *pokemonServer) GetMove(ctx context.Context, req *pb.MoveRequest) (*pb.MoveResponse, error) {
+	query := slug(req.Query)
+
+	if query == "" {
+		return &pb.MoveResponse{
+			Success: false,
+			Message: "Please enter a move name or ID",
+		}, nil
+	}
+
+	log.Printf("Fetching move: %s", query)
+
+	data, err := s.api.getMove(ctx, query)
+	if err != nil {
+		return &pb.MoveResponse{
+			Success: false,
+			Message: moveFetchError(err),
+		}, nil
+	}
+
+	return &pb.MoveResponse{
+		Success: true,
+		Message: "Move found!",
+		Move:    toMove(data),
+	}, nil
+}
+
+func (s *pokemonServer) GetMoveset(ctx context.Context, req *pb.MovesetRequest) (*pb.MovesetResponse, error) {
+	query := normalizeQuery(req.Query)
+
+	if query == "" {
+		return &pb.MovesetResponse{
+			Success: false,
+			Message: "Please enter a Pokemon name or ID",
+		}, nil
+	}
+
+	data, err := s.api.getPokemon(ctx, query)
+	if err != nil {
+		return &pb.MovesetResponse{
+			Success: false,
+			Message: fetchError(err),
+		}, nil
+	}
+
+	versionGroup := slug(req.VersionGroup)
+	moves := learnableMoves(data, versionGroup)
+	if len(moves) == 0 && versionGroup != "" {
+		return &pb.MovesetResponse{
+			Success: false,
+			Message: fmt.Sprintf("%s can't learn any moves in %s", strings.Title(data.Name), versionGroup),
+		}, nil
+	}
+
+	return &pb.MovesetResponse{
+		Success: true,
+		Message: fmt.Sprintf("Found %d moves", len(moves)),
+		Moves:   moves,
+	}, nil
+}
+
+// learnableMoves lists how a Pokemon learns each move in a version group.
+// Without a version group, the latest entry PokeAPI has for each move is used.
+// Level-up moves come first, ordered by level.
+func learnableMoves(data *PokeAPIResponse, versionGroup string) []*pb.LearnableMove {
+	var moves []*pb.LearnableMove
+	for _, m := range data.Moves {
+		details := m.VersionGroupDetails
+		if versionGroup == "" && len(details) > 0 {
+			details = details[len(details)-1:]
+		}
+		for _, d := range details {
+			if versionGroup != "" && d.VersionGroup.Name != versionGroup {
+				continue
+			}
+			moves = append(moves, &pb.LearnableMove{
+				Name:         displayName(m.Move.Name),
+				LearnMethod:  d.MoveLearnMethod.Name,
+				Level:        int32(d.LevelLearnedAt),
+				VersionGroup: d.VersionGroup.Name,
+			})
+		}
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		a, b := moves[i], moves[j]
+		if a.LearnMethod != b.LearnMethod {
+			if a.LearnMethod == "level-up" || b.LearnMethod == "level-up" {
+				return a.LearnMethod == "level-up"
+			}
+			return a.LearnMethod < b.LearnMethod
+		}
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		return a.Name < b.Name
+	})
+	return moves
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "grpc/proto"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const maxSuggestions = 3
+
+// Words players put in front of a name that PokeAPI puts after it,
+// e.g. "Alolan Vulpix" is "vulpix-alola".
+var formPrefixes = map[string]string{
+	"alolan":     "alola",
+	"galarian":   "galar",
+	"hisuian":    "hisui",
+	"paldean":    "paldea",
+	"mega":       "mega",
+	"primal":     "primal",
+	"gigantamax": "gmax",
+	"gmax":       "gmax",
+}
+
+var stripAccents = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// pokemonSlug maps a name the way players type it to PokeAPI's slug:
+// "Mr. Mime" -> "mr-mime", "Farfetch'd" -> "farfetchd",
+// "Nidoran♀" -> "nidoran-f", "Alolan Vulpix" -> "vulpix-alola",
+// "Mega Charizard X" -> "charizard-mega-x".
+func pokemonSlug(query string) string {
+	words := nameWords(query)
+
+	// Move a leading form word after the species name
+	if len(words) > 1 {
+		if form, ok := formPrefixes[words[0]]; ok {
+			words = append([]string{words[1], form}, words[2:]...)
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+// nameWords splits a name into the lowercase, unaccented words PokeAPI
+// joins with hyphens, dropping punctuation it leaves out of slugs.
+func nameWords(query string) []string {
+	query = normalizeQuery(query)
+	if stripped, _, err := transform.String(stripAccents, query); err == nil {
+		query = stripped
+	}
+
+	query = strings.ReplaceAll(query, "♀", " f")
+	query = strings.ReplaceAll(query, "♂", " m")
+	query = strings.Map(func(r rune) rune {
+		switch r {
+		case '\'', '’', '.', ':':
+			return -1
+		case '_', '-':
+			return ' '
+		}
+		return r
+	}, query)
+	return strings.Fields(query)
+}
+
+// formSlug maps a requested form like "Alolan" or "mega x" to its slug suffix.
+func formSlug(form string) string {
+	words := strings.Fields(strings.ReplaceAll(normalizeQuery(form), "-", " "))
+	if len(words) > 0 {
+		if f, ok := formPrefixes[words[0]]; ok {
+			words[0] = f
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+// getPokemonForm resolves a player supplied name, and optionally a form,
+// to a Pokemon. Species whose default Pokemon has a form suffix, like
+// "deoxys" (deoxys-normal), fall back to the species' default variety.
+func (a *pokeAPI) getPokemonForm(ctx context.Context, query, form string) (*PokeAPIResponse, error) {
+	slug := pokemonSlug(query)
+	if suffix := formSlug(form); suffix != "" && !strings.HasSuffix(slug, "-"+suffix) {
+		slug += "-" + suffix
+	}
+
+	var data PokeAPIResponse
+	err := a.get(ctx, "pokemon/"+slug, &data)
+	if err == nil {
+		return &data, nil
+	}
+	// A requested form must match exactly
+	if !errors.Is(err, errNotFound) || form != "" {
+		return nil, err
+	}
+
+	species, speciesErr := a.getSpecies(ctx, slug)
+	if speciesErr != nil {
+		return nil, err
+	}
+	for _, v := range species.Varieties {
+		if v.IsDefault && v.Pokemon.Name != slug {
+			if err := a.get(ctx, "pokemon/"+v.Pokemon.Name, &data); err != nil {
+				return nil, err
+			}
+			return &data, nil
+		}
+	}
+	return nil, err
+}
+
+// cachedPokemon reports whether getPokemon can serve query without calling
+// PokeAPI, following a species to its default variety the same way.
+func (a *pokeAPI) cachedPokemon(query string) bool {
+	slug := pokemonSlug(query)
+	if a.cached("pokemon/" + slug) {
+		return true
+	}
+
+	body, ok := a.lookup("pokemon-species/" + slug)
+	if !ok {
+		return false
+	}
+	var species PokeAPISpecies
+	if err := json.Unmarshal(body, &species); err != nil {
+		return false
+	}
+	for _, v := range species.Varieties {
+		if v.IsDefault {
+			return a.cached("pokemon/" + v.Pokemon.Name)
+		}
+	}
+	return false
+}
+
+type pokemonList struct {
+	Results []struct {
+		Name string `json:"name"`
+	} `json:"results"`
+}
+
+// suggest returns the known Pokemon names closest to a failed query.
+func (a *pokeAPI) suggest(ctx context.Context, query string) []string {
+	var list pokemonList
+	if err := a.get(ctx, "pokemon?limit=100000", &list); err != nil {
+		return nil
+	}
+
+	names := make([]string, len(list.Results))
+	for i, r := range list.Results {
+		names[i] = r.Name
+	}
+	return closestNames(pokemonSlug(query), names, maxSuggestions)
+}
+
+// closestNames ranks names by edit distance to slug, treating a name that
+// starts with slug as a near match. Names too far away are left out.
+func closestNames(slug string, names []string, limit int) []string {
+	if slug == "" {
+		return nil
+	}
+
+	maxDistance := max(2, len(slug)/3)
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range names {
+		d := editDistance(slug, name)
+		if len(slug) >= 3 && strings.HasPrefix(name, slug) {
+			d = min(d, 1)
+		}
+		if d <= maxDistance {
+			candidates = append(candidates, candidate{name, d})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var suggestions []string
+	for _, c := range candidates {
+		if len(suggestions) == limit {
+			break
+		}
+		suggestions = append(suggestions, strings.Title(c.name))
+	}
+	return suggestions
+}
+
+// editDistance is the Damerau-Levenshtein (optimal string alignment)
+// distance, so swapped letters like "pikahcu" count as one edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+// notFoundResponse builds the failed lookup response, with close matches
+// when the name wasn't found.
+func (a *pokeAPI) notFoundResponse(ctx context.Context, query string, err error) *pb.PokemonResponse {
+	resp := &pb.PokemonResponse{
+		Success: false,
+		Message: fetchError(err),
+	}
+	if errors.Is(err, errNotFound) {
+		resp.Suggestions = a.suggest(ctx, query)
+		if len(resp.Suggestions) > 0 {
+			resp.Message = fmt.Sprintf("Pokemon not found. Did you mean %s?", strings.Join(resp.Suggestions, ", "))
+		}
+	}
+	return resp
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPokemonSlug(t *testing.T) {
+	tests := map[string]string{
+		"Pikachu":          "pikachu",
+		"Mr. Mime":         "mr-mime",
+		"Farfetch'd":       "farfetchd",
+		"Flabébé":          "flabebe",
+		"Nidoran♀":         "nidoran-f",
+		"Type: Null":       "type-null",
+		"Alolan Vulpix":    "vulpix-alola",
+		"Mega Charizard X": "charizard-mega-x",
+		"  25 ":            "25",
+	}
+	for query, want := range tests {
+		if got := pokemonSlug(query); got != want {
+			t.Errorf("pokemonSlug(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"pikachu", "pikachu", 0},
+		{"pikahcu", "pikachu", 1},
+		{"pikchu", "pikachu", 1},
+		{"charzard", "charizard", 1},
+		{"", "abc", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosestNames(t *testing.T) {
+	names := []string{"pikachu", "pichu", "raichu", "charizard", "charmander", "bulbasaur"}
+
+	got := closestNames("pikahcu", names, 3)
+	if want := []string{"Pikachu"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("closestNames(pikahcu) = %v, want %v", got, want)
+	}
+
+	got = closestNames("char", names, 3)
+	if want := []string{"Charizard", "Charmander"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("closestNames(char) = %v, want %v", got, want)
+	}
+
+	if got := closestNames("xyzzyplugh", names, 3); len(got) != 0 {
+		t.Errorf("expected no suggestions, got %v", got)
+	}
+}
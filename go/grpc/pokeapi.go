@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	pb "grpc/proto"
+)
+
+const (
+	pokeAPIBaseURL  = "https://pokeapi.co/api/v2"
+	pokeAPICacheTTL = 24 * time.Hour
+)
+
+var errNotFound = errors.New("not found")
+
+// PokeAPI response structures
+type PokeAPIResponse struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Height  int    `json:"height"`
+	Weight  int    `json:"weight"`
+	Species struct {
+		Name string `json:"name"`
+	} `json:"species"`
+	Types []struct {
+		Type struct {
+			Name string `json:"name"`
+		} `json:"type"`
+	} `json:"types"`
+	Stats []struct {
+		BaseStat int `json:"base_stat"`
+		Stat     struct {
+			Name string `json:"name"`
+		} `json:"stat"`
+	} `json:"stats"`
+	Moves []struct {
+		Move struct {
+			Name string `json:"name"`
+		} `json:"move"`
+		VersionGroupDetails []struct {
+			LevelLearnedAt  int `json:"level_learned_at"`
+			MoveLearnMethod struct {
+				Name string `json:"name"`
+			} `json:"move_learn_method"`
+			VersionGroup struct {
+				Name string `json:"name"`
+			} `json:"version_group"`
+		} `json:"version_group_details"`
+	} `json:"moves"`
+	Sprites struct {
+		FrontDefault string `json:"front_default"`
+		Other        struct {
+			OfficialArtwork struct {
+				FrontDefault string `json:"front_default"`
+			} `json:"official-artwork"`
+		} `json:"other"`
+	} `json:"sprites"`
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// pokeAPI fetches resources from PokeAPI and keeps successful responses
+// in memory so repeated lookups don't hit the network.
+type pokeAPI struct {
+	baseURL string
+	client  *http.Client
+	ttl     time.Duration
+
+	mu    sync.RWMutex
+	cache map[string]cacheEntry
+}
+
+func newPokeAPI(baseURL string) *pokeAPI {
+	return &pokeAPI{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+		ttl:     pokeAPICacheTTL,
+		cache:   make(map[string]cacheEntry),
+	}
+}
+
+// get fetches path (e.g. "pokemon/25") and decodes the JSON body into v.
+func (a *pokeAPI) get(ctx context.Context, path string, v any) error {
+	if body, ok := a.lookup(path); ok {
+		return json.Unmarshal(body, v)
+	}
+
+	if limit := callLimitFromContext(ctx); limit != nil {
+		if err := limit.acquireUpstream(ctx); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.baseURL+"/"+path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error: status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read API response: %w", err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to parse API response: %w", err)
+	}
+
+	a.store(path, body)
+	return nil
+}
+
+func (a *pokeAPI) lookup(path string) ([]byte, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	entry, ok := a.cache[path]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// cached reports whether path can be served without calling PokeAPI.
+func (a *pokeAPI) cached(path string) bool {
+	_, ok := a.lookup(path)
+	return ok
+}
+
+func (a *pokeAPI) store(path string, body []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.cache[path] = cacheEntry{body: body, expires: time.Now().Add(a.ttl)}
+}
+
+// getPokemon fetches a Pokemon by ID or name, see pokemonSlug for the
+// spellings it accepts.
+func (a *pokeAPI) getPokemon(ctx context.Context, query string) (*PokeAPIResponse, error) {
+	return a.getPokemonForm(ctx, query, "")
+}
+
+// toPokemon converts a PokeAPI response into the protobuf message.
+func toPokemon(data *PokeAPIResponse) *pb.Pokemon {
+	// Extract types
+	types := make([]string, len(data.Types))
+	for i, t := range data.Types {
+		types[i] = strings.Title(t.Type.Name)
+	}
+
+	stats := make([]*pb.Stat, len(data.Stats))
+	for i, s := range data.Stats {
+		stats[i] = &pb.Stat{Name: s.Stat.Name, BaseStat: int32(s.BaseStat)}
+	}
+
+	// Prefer official artwork, fallback to sprite
+	imageURL := data.Sprites.Other.OfficialArtwork.FrontDefault
+	if imageURL == "" {
+		imageURL = data.Sprites.FrontDefault
+	}
+
+	return &pb.Pokemon{
+		Id:       int32(data.ID),
+		Name:     strings.Title(data.Name),
+		Types:    types,
+		ImageUrl: imageURL,
+		Height:   int32(data.Height),
+		Weight:   int32(data.Weight),
+		Stats:    stats,
+	}
+}
+
+// normalizeQuery lowercases and trims a user supplied name or ID.
+func normalizeQuery(query string) string {
+	return strings.TrimSpace(strings.ToLower(query))
+}
+
+// fetchError turns an upstream error into a user facing message.
+func fetchError(err error) string {
+	if errors.Is(err, errNotFound) {
+		return "Pokemon not found. Try a different name or ID (1-1025)"
+	}
+	if errors.Is(err, errRateLimited) {
+		return "Too many Pokemon fetched from PokeAPI, try again shortly"
+	}
+	return fmt.Sprintf("Failed to fetch Pokemon: %v", err)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"log"
+	"strconv"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *pokemonServer) StreamPokedex(req *pb.PokedexRequest, stream pb.PokemonService_StreamPokedexServer) error {
+	from, to := int(req.FromId), int(req.ToId)
+	if req.All {
+		from, to = 1, maxPokedexID
+	}
+	if from < 1 || to > maxPokedexID || from > to {
+		return status.Errorf(codes.InvalidArgument, "ID range must be within 1-%d", maxPokedexID)
+	}
+
+	log.Printf("Streaming Pokedex %d-%d", from, to)
+
+	// A cold stream fetches far more than the upstream burst, so it waits
+	// for tokens rather than failing part way, like exports do
+	if limit := callLimitFromContext(stream.Context()); limit != nil {
+		limit.pace()
+	}
+
+	queries := make([]string, 0, to-from+1)
+	for id := from; id <= to; id++ {
+		queries = append(queries, strconv.Itoa(id))
+	}
+
+	// Entries arrive in order, so a client whose stream fails can resume
+	// from the ID after the last one it received.
+	return s.api.fetchOrdered(stream.Context(), queries, defaultFetchConcurrency, func(i int, data *PokeAPIResponse, err error) error {
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "%s: %s", queries[i], fetchError(err))
+		}
+		return stream.Send(toPokemon(data))
+	})
+}
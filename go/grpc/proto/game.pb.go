@@ -24,7 +24,8 @@ const (
 // Messages
 type PokemonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Can be ID (e.g., "25") or name (e.g., "pikachu")
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Can be ID (e.g., "25") or name (e.g., "pikachu", "Mr. Mime", "Alolan Vulpix")
+	Form          string                 `protobuf:"bytes,2,opt,name=form,proto3" json:"form,omitempty"`   // Optional form, e.g. "alola", "galar", "mega-x", "gmax"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,11 +67,19 @@ func (x *PokemonRequest) GetQuery() string {
 	return ""
 }
 
+func (x *PokemonRequest) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
 type PokemonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Pokemon       *Pokemon               `protobuf:"bytes,3,opt,name=pokemon,proto3" json:"pokemon,omitempty"`
+	Suggestions   []string               `protobuf:"bytes,4,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Close matches when the Pokemon wasn't found
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +135,13 @@ func (x *PokemonResponse) GetPokemon() *Pokemon {
 	return nil
 }
 
+func (x *PokemonResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
 type Pokemon struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -134,6 +150,7 @@ type Pokemon struct {
 	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 	Weight        int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Stats         []*Stat                `protobuf:"bytes,7,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +227,65 @@ func (x *Pokemon) GetWeight() int32 {
 	return 0
 }
 
+func (x *Pokemon) GetStats() []*Stat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type Stat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "hp", "special-attack"
+	BaseStat      int32                  `protobuf:"varint,2,opt,name=base_stat,json=baseStat,proto3" json:"base_stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stat) Reset() {
+	*x = Stat{}
+	mi := &file_proto_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stat) ProtoMessage() {}
+
+func (x *Stat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stat.ProtoReflect.Descriptor instead.
+func (*Stat) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Stat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stat) GetBaseStat() int32 {
+	if x != nil {
+		return x.BaseStat
+	}
+	return 0
+}
+
 type SearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -220,7 +296,7 @@ type SearchRequest struct {
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_proto_game_proto_msgTypes[3]
+	mi := &file_proto_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +308,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_proto_msgTypes[3]
+	mi := &file_proto_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +321,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_proto_rawDescGZIP(), []int{3}
+	return file_proto_game_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchRequest) GetQuery() string {
@@ -271,7 +347,7 @@ type SearchResponse struct {
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
-	mi := &file_proto_game_proto_msgTypes[4]
+	mi := &file_proto_game_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +359,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_proto_msgTypes[4]
+	mi := &file_proto_game_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +372,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_proto_rawDescGZIP(), []int{4}
+	return file_proto_game_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchResponse) GetResults() []*Pokemon {
@@ -306,73 +382,5126 @@ func (x *SearchResponse) GetResults() []*Pokemon {
 	return nil
 }
 
-var File_proto_game_proto protoreflect.FileDescriptor
+type CompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"` // Two to six IDs or names
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_game_proto_rawDesc = "" +
-	"\n" +
-	"\x10proto/game.proto\x12\apokemon\"&\n" +
-	"\x0ePokemonRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\"q\n" +
-	"\x0fPokemonResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
-	"\apokemon\x18\x03 \x01(\v2\x10.pokemon.PokemonR\apokemon\"\x90\x01\n" +
-	"\aPokemon\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05types\x18\x03 \x03(\tR\x05types\x12\x1b\n" +
-	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x16\n" +
-	"\x06height\x18\x05 \x01(\x05R\x06height\x12\x16\n" +
-	"\x06weight\x18\x06 \x01(\x05R\x06weight\";\n" +
-	"\rSearchRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"<\n" +
-	"\x0eSearchResponse\x12*\n" +
-	"\aresults\x18\x01 \x03(\v2\x10.pokemon.PokemonR\aresults2\x93\x01\n" +
-	"\x0ePokemonService\x12?\n" +
-	"\n" +
-	"GetPokemon\x12\x17.pokemon.PokemonRequest\x1a\x18.pokemon.PokemonResponse\x12@\n" +
-	"\rSearchPokemon\x12\x16.pokemon.SearchRequest\x1a\x17.pokemon.SearchResponseBA\n" +
-	"\x15dev.unifuu.grpc.protoP\x01Z&github.com/unifuu/mewtwo/go/grpc/protob\x06proto3"
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	mi := &file_proto_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_proto_game_proto_rawDescOnce sync.Once
-	file_proto_game_proto_rawDescData []byte
-)
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_proto_game_proto_rawDescGZIP() []byte {
-	file_proto_game_proto_rawDescOnce.Do(func() {
-		file_proto_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_game_proto_rawDesc), len(file_proto_game_proto_rawDesc)))
-	})
-	return file_proto_game_proto_rawDescData
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_proto_game_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_proto_game_proto_goTypes = []any{
-	(*PokemonRequest)(nil),  // 0: pokemon.PokemonRequest
-	(*PokemonResponse)(nil), // 1: pokemon.PokemonResponse
-	(*Pokemon)(nil),         // 2: pokemon.Pokemon
-	(*SearchRequest)(nil),   // 3: pokemon.SearchRequest
-	(*SearchResponse)(nil),  // 4: pokemon.SearchResponse
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{6}
 }
-var file_proto_game_proto_depIdxs = []int32{
-	2, // 0: pokemon.PokemonResponse.pokemon:type_name -> pokemon.Pokemon
-	2, // 1: pokemon.SearchResponse.results:type_name -> pokemon.Pokemon
-	0, // 2: pokemon.PokemonService.GetPokemon:input_type -> pokemon.PokemonRequest
-	3, // 3: pokemon.PokemonService.SearchPokemon:input_type -> pokemon.SearchRequest
-	1, // 4: pokemon.PokemonService.GetPokemon:output_type -> pokemon.PokemonResponse
-	4, // 5: pokemon.PokemonService.SearchPokemon:output_type -> pokemon.SearchResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *CompareRequest) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
 }
 
-func init() { file_proto_game_proto_init() }
-func file_proto_game_proto_init() {
-	if File_proto_game_proto != nil {
-		return
+type CompareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Pokemon       []*Pokemon             `protobuf:"bytes,3,rep,name=pokemon,proto3" json:"pokemon,omitempty"`                            // Table columns, in request order
+	Stats         []*ComparisonRow       `protobuf:"bytes,4,rep,name=stats,proto3" json:"stats,omitempty"`                                // One row per base stat, plus "total"
+	Measurements  []*ComparisonRow       `protobuf:"bytes,5,rep,name=measurements,proto3" json:"measurements,omitempty"`                  // "height" and "weight" rows
+	SharedTypes   []string               `protobuf:"bytes,6,rep,name=shared_types,json=sharedTypes,proto3" json:"shared_types,omitempty"` // Types every compared Pokemon has
+	Matchups      []*Matchup             `protobuf:"bytes,7,rep,name=matchups,proto3" json:"matchups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
+	mi := &file_proto_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResponse) ProtoMessage() {}
+
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompareResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CompareResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetPokemon() []*Pokemon {
+	if x != nil {
+		return x.Pokemon
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetStats() []*ComparisonRow {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetMeasurements() []*ComparisonRow {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetSharedTypes() []string {
+	if x != nil {
+		return x.SharedTypes
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetMatchups() []*Matchup {
+	if x != nil {
+		return x.Matchups
+	}
+	return nil
+}
+
+// A table row with one value per compared Pokemon.
+type ComparisonRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []int32                `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Deltas        []int32                `protobuf:"varint,3,rep,packed,name=deltas,proto3" json:"deltas,omitempty"`                 // Value minus the first Pokemon's value
+	BestIndex     int32                  `protobuf:"varint,4,opt,name=best_index,json=bestIndex,proto3" json:"best_index,omitempty"` // Column with the highest value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparisonRow) Reset() {
+	*x = ComparisonRow{}
+	mi := &file_proto_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparisonRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparisonRow) ProtoMessage() {}
+
+func (x *ComparisonRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparisonRow.ProtoReflect.Descriptor instead.
+func (*ComparisonRow) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ComparisonRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ComparisonRow) GetDeltas() []int32 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+func (x *ComparisonRow) GetBestIndex() int32 {
+	if x != nil {
+		return x.BestIndex
+	}
+	return 0
+}
+
+// How the attacker's own types fare against the defender's typing.
+type Matchup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AttackerIndex  int32                  `protobuf:"varint,1,opt,name=attacker_index,json=attackerIndex,proto3" json:"attacker_index,omitempty"`
+	DefenderIndex  int32                  `protobuf:"varint,2,opt,name=defender_index,json=defenderIndex,proto3" json:"defender_index,omitempty"`
+	Multipliers    []*TypeMultiplier      `protobuf:"bytes,3,rep,name=multipliers,proto3" json:"multipliers,omitempty"` // One entry per attacker type
+	BestMultiplier float64                `protobuf:"fixed64,4,opt,name=best_multiplier,json=bestMultiplier,proto3" json:"best_multiplier,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Matchup) Reset() {
+	*x = Matchup{}
+	mi := &file_proto_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Matchup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matchup) ProtoMessage() {}
+
+func (x *Matchup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matchup.ProtoReflect.Descriptor instead.
+func (*Matchup) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Matchup) GetAttackerIndex() int32 {
+	if x != nil {
+		return x.AttackerIndex
+	}
+	return 0
+}
+
+func (x *Matchup) GetDefenderIndex() int32 {
+	if x != nil {
+		return x.DefenderIndex
+	}
+	return 0
+}
+
+func (x *Matchup) GetMultipliers() []*TypeMultiplier {
+	if x != nil {
+		return x.Multipliers
+	}
+	return nil
+}
+
+func (x *Matchup) GetBestMultiplier() float64 {
+	if x != nil {
+		return x.BestMultiplier
+	}
+	return 0
+}
+
+type TypeMultiplier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeMultiplier) Reset() {
+	*x = TypeMultiplier{}
+	mi := &file_proto_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeMultiplier) ProtoMessage() {}
+
+func (x *TypeMultiplier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeMultiplier.ProtoReflect.Descriptor instead.
+func (*TypeMultiplier) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TypeMultiplier) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TypeMultiplier) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type QuizRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId            string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Rounds              int32                  `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`                                                        // Defaults to 10
+	MinGeneration       int32                  `protobuf:"varint,3,opt,name=min_generation,json=minGeneration,proto3" json:"min_generation,omitempty"`                     // 1-9, defaults to 1
+	MaxGeneration       int32                  `protobuf:"varint,4,opt,name=max_generation,json=maxGeneration,proto3" json:"max_generation,omitempty"`                     // 1-9, defaults to 9
+	HintIntervalSeconds int32                  `protobuf:"varint,5,opt,name=hint_interval_seconds,json=hintIntervalSeconds,proto3" json:"hint_interval_seconds,omitempty"` // Defaults to 10
+	Seed                int64                  `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`                                                            // Optional, makes the picked Pokemon reproducible
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QuizRequest) Reset() {
+	*x = QuizRequest{}
+	mi := &file_proto_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRequest) ProtoMessage() {}
+
+func (x *QuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRequest.ProtoReflect.Descriptor instead.
+func (*QuizRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuizRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *QuizRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetMinGeneration() int32 {
+	if x != nil {
+		return x.MinGeneration
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetMaxGeneration() int32 {
+	if x != nil {
+		return x.MaxGeneration
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetHintIntervalSeconds() int32 {
+	if x != nil {
+		return x.HintIntervalSeconds
+	}
+	return 0
+}
+
+func (x *QuizRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type QuizEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Round     int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*QuizEvent_RoundStart
+	//	*QuizEvent_Hint
+	//	*QuizEvent_RoundResult
+	//	*QuizEvent_Summary
+	Event         isQuizEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizEvent) Reset() {
+	*x = QuizEvent{}
+	mi := &file_proto_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizEvent) ProtoMessage() {}
+
+func (x *QuizEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizEvent.ProtoReflect.Descriptor instead.
+func (*QuizEvent) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuizEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *QuizEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *QuizEvent) GetEvent() isQuizEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *QuizEvent) GetRoundStart() *QuizRoundStart {
+	if x != nil {
+		if x, ok := x.Event.(*QuizEvent_RoundStart); ok {
+			return x.RoundStart
+		}
+	}
+	return nil
+}
+
+func (x *QuizEvent) GetHint() *QuizHint {
+	if x != nil {
+		if x, ok := x.Event.(*QuizEvent_Hint); ok {
+			return x.Hint
+		}
+	}
+	return nil
+}
+
+func (x *QuizEvent) GetRoundResult() *QuizRoundResult {
+	if x != nil {
+		if x, ok := x.Event.(*QuizEvent_RoundResult); ok {
+			return x.RoundResult
+		}
+	}
+	return nil
+}
+
+func (x *QuizEvent) GetSummary() *QuizSummary {
+	if x != nil {
+		if x, ok := x.Event.(*QuizEvent_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isQuizEvent_Event interface {
+	isQuizEvent_Event()
+}
+
+type QuizEvent_RoundStart struct {
+	RoundStart *QuizRoundStart `protobuf:"bytes,3,opt,name=round_start,json=roundStart,proto3,oneof"`
+}
+
+type QuizEvent_Hint struct {
+	Hint *QuizHint `protobuf:"bytes,4,opt,name=hint,proto3,oneof"`
+}
+
+type QuizEvent_RoundResult struct {
+	RoundResult *QuizRoundResult `protobuf:"bytes,5,opt,name=round_result,json=roundResult,proto3,oneof"`
+}
+
+type QuizEvent_Summary struct {
+	Summary *QuizSummary `protobuf:"bytes,6,opt,name=summary,proto3,oneof"`
+}
+
+func (*QuizEvent_RoundStart) isQuizEvent_Event() {}
+
+func (*QuizEvent_Hint) isQuizEvent_Event() {}
+
+func (*QuizEvent_RoundResult) isQuizEvent_Event() {}
+
+func (*QuizEvent_Summary) isQuizEvent_Event() {}
+
+type QuizRoundStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRounds   int32                  `protobuf:"varint,1,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	SilhouetteUrl string                 `protobuf:"bytes,2,opt,name=silhouette_url,json=silhouetteUrl,proto3" json:"silhouette_url,omitempty"` // PNG data URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizRoundStart) Reset() {
+	*x = QuizRoundStart{}
+	mi := &file_proto_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizRoundStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRoundStart) ProtoMessage() {}
+
+func (x *QuizRoundStart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRoundStart.ProtoReflect.Descriptor instead.
+func (*QuizRoundStart) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuizRoundStart) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *QuizRoundStart) GetSilhouetteUrl() string {
+	if x != nil {
+		return x.SilhouetteUrl
+	}
+	return ""
+}
+
+type QuizHint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "type", "first_letter" or "generation"
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizHint) Reset() {
+	*x = QuizHint{}
+	mi := &file_proto_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizHint) ProtoMessage() {}
+
+func (x *QuizHint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizHint.ProtoReflect.Descriptor instead.
+func (*QuizHint) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QuizHint) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QuizHint) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type QuizRoundResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        *Pokemon               `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	Solved        bool                   `protobuf:"varint,2,opt,name=solved,proto3" json:"solved,omitempty"`
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	ElapsedMs     int64                  `protobuf:"varint,4,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Streak        int32                  `protobuf:"varint,6,opt,name=streak,proto3" json:"streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizRoundResult) Reset() {
+	*x = QuizRoundResult{}
+	mi := &file_proto_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizRoundResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizRoundResult) ProtoMessage() {}
+
+func (x *QuizRoundResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizRoundResult.ProtoReflect.Descriptor instead.
+func (*QuizRoundResult) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuizRoundResult) GetAnswer() *Pokemon {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *QuizRoundResult) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+func (x *QuizRoundResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizRoundResult) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *QuizRoundResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizRoundResult) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+type QuizSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	SolvedRounds  int32                  `protobuf:"varint,3,opt,name=solved_rounds,json=solvedRounds,proto3" json:"solved_rounds,omitempty"`
+	TotalRounds   int32                  `protobuf:"varint,4,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	BestStreak    int32                  `protobuf:"varint,5,opt,name=best_streak,json=bestStreak,proto3" json:"best_streak,omitempty"`
+	RoundTimesMs  []int64                `protobuf:"varint,6,rep,packed,name=round_times_ms,json=roundTimesMs,proto3" json:"round_times_ms,omitempty"`
+	Player        *QuizPlayerStats       `protobuf:"bytes,7,opt,name=player,proto3" json:"player,omitempty"` // Totals across all of the player's quizzes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizSummary) Reset() {
+	*x = QuizSummary{}
+	mi := &file_proto_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizSummary) ProtoMessage() {}
+
+func (x *QuizSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizSummary.ProtoReflect.Descriptor instead.
+func (*QuizSummary) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuizSummary) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *QuizSummary) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizSummary) GetSolvedRounds() int32 {
+	if x != nil {
+		return x.SolvedRounds
+	}
+	return 0
+}
+
+func (x *QuizSummary) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *QuizSummary) GetBestStreak() int32 {
+	if x != nil {
+		return x.BestStreak
+	}
+	return 0
+}
+
+func (x *QuizSummary) GetRoundTimesMs() []int64 {
+	if x != nil {
+		return x.RoundTimesMs
+	}
+	return nil
+}
+
+func (x *QuizSummary) GetPlayer() *QuizPlayerStats {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type QuizPlayerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GamesPlayed   int32                  `protobuf:"varint,1,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	TotalScore    int32                  `protobuf:"varint,2,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	BestStreak    int32                  `protobuf:"varint,3,opt,name=best_streak,json=bestStreak,proto3" json:"best_streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizPlayerStats) Reset() {
+	*x = QuizPlayerStats{}
+	mi := &file_proto_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizPlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizPlayerStats) ProtoMessage() {}
+
+func (x *QuizPlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizPlayerStats.ProtoReflect.Descriptor instead.
+func (*QuizPlayerStats) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QuizPlayerStats) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *QuizPlayerStats) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *QuizPlayerStats) GetBestStreak() int32 {
+	if x != nil {
+		return x.BestStreak
+	}
+	return 0
+}
+
+type QuizAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Guess         string                 `protobuf:"bytes,2,opt,name=guess,proto3" json:"guess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAnswer) Reset() {
+	*x = QuizAnswer{}
+	mi := &file_proto_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswer) ProtoMessage() {}
+
+func (x *QuizAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswer.ProtoReflect.Descriptor instead.
+func (*QuizAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QuizAnswer) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *QuizAnswer) GetGuess() string {
+	if x != nil {
+		return x.Guess
+	}
+	return ""
+}
+
+type QuizAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Correct       bool                   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Score         int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Streak        int32                  `protobuf:"varint,6,opt,name=streak,proto3" json:"streak,omitempty"`
+	ElapsedMs     int64                  `protobuf:"varint,7,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAnswerResponse) Reset() {
+	*x = QuizAnswerResponse{}
+	mi := &file_proto_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswerResponse) ProtoMessage() {}
+
+func (x *QuizAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswerResponse.ProtoReflect.Descriptor instead.
+func (*QuizAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QuizAnswerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QuizAnswerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QuizAnswerResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *QuizAnswerResponse) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuizAnswerResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizAnswerResponse) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *QuizAnswerResponse) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+type Move struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Power         int32                  `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`       // 0 for status and variable power moves
+	Accuracy      int32                  `protobuf:"varint,5,opt,name=accuracy,proto3" json:"accuracy,omitempty"` // 0 for moves that never miss
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`  // "physical", "special" or "status"
+	Pp            int32                  `protobuf:"varint,7,opt,name=pp,proto3" json:"pp,omitempty"`
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Effect        string                 `protobuf:"bytes,9,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	mi := &file_proto_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Move) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Move) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Move) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Move) GetPower() int32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *Move) GetAccuracy() int32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *Move) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Move) GetPp() int32 {
+	if x != nil {
+		return x.Pp
+	}
+	return 0
+}
+
+func (x *Move) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Move) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type MoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Can be ID (e.g., "89") or name (e.g., "earthquake")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveRequest) Reset() {
+	*x = MoveRequest{}
+	mi := &file_proto_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequest) ProtoMessage() {}
+
+func (x *MoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MoveRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type MoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Move          *Move                  `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveResponse) Reset() {
+	*x = MoveResponse{}
+	mi := &file_proto_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveResponse) ProtoMessage() {}
+
+func (x *MoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveResponse.ProtoReflect.Descriptor instead.
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MoveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MoveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MoveResponse) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+type MovesetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                   // Pokemon ID or name
+	VersionGroup  string                 `protobuf:"bytes,2,opt,name=version_group,json=versionGroup,proto3" json:"version_group,omitempty"` // e.g. "scarlet-violet", defaults to each move's latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovesetRequest) Reset() {
+	*x = MovesetRequest{}
+	mi := &file_proto_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovesetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovesetRequest) ProtoMessage() {}
+
+func (x *MovesetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovesetRequest.ProtoReflect.Descriptor instead.
+func (*MovesetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MovesetRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MovesetRequest) GetVersionGroup() string {
+	if x != nil {
+		return x.VersionGroup
+	}
+	return ""
+}
+
+type MovesetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Moves         []*LearnableMove       `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovesetResponse) Reset() {
+	*x = MovesetResponse{}
+	mi := &file_proto_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovesetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovesetResponse) ProtoMessage() {}
+
+func (x *MovesetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovesetResponse.ProtoReflect.Descriptor instead.
+func (*MovesetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MovesetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MovesetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MovesetResponse) GetMoves() []*LearnableMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type LearnableMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LearnMethod   string                 `protobuf:"bytes,2,opt,name=learn_method,json=learnMethod,proto3" json:"learn_method,omitempty"` // e.g. "level-up", "machine", "egg", "tutor"
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                               // Only set for level-up moves
+	VersionGroup  string                 `protobuf:"bytes,4,opt,name=version_group,json=versionGroup,proto3" json:"version_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearnableMove) Reset() {
+	*x = LearnableMove{}
+	mi := &file_proto_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnableMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnableMove) ProtoMessage() {}
+
+func (x *LearnableMove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnableMove.ProtoReflect.Descriptor instead.
+func (*LearnableMove) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LearnableMove) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LearnableMove) GetLearnMethod() string {
+	if x != nil {
+		return x.LearnMethod
+	}
+	return ""
+}
+
+func (x *LearnableMove) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *LearnableMove) GetVersionGroup() string {
+	if x != nil {
+		return x.VersionGroup
+	}
+	return ""
+}
+
+type DamageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attacker      string                 `protobuf:"bytes,1,opt,name=attacker,proto3" json:"attacker,omitempty"`
+	Defender      string                 `protobuf:"bytes,2,opt,name=defender,proto3" json:"defender,omitempty"`
+	Move          string                 `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	AttackerLevel int32                  `protobuf:"varint,4,opt,name=attacker_level,json=attackerLevel,proto3" json:"attacker_level,omitempty"` // Defaults to 50
+	DefenderLevel int32                  `protobuf:"varint,5,opt,name=defender_level,json=defenderLevel,proto3" json:"defender_level,omitempty"` // Defaults to 50
+	Critical      bool                   `protobuf:"varint,6,opt,name=critical,proto3" json:"critical,omitempty"`
+	Weather       string                 `protobuf:"bytes,7,opt,name=weather,proto3" json:"weather,omitempty"`                                    // "sun", "rain", "sand" or "snow"
+	OtherModifier float64                `protobuf:"fixed64,8,opt,name=other_modifier,json=otherModifier,proto3" json:"other_modifier,omitempty"` // Extra multiplier (items, abilities), defaults to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DamageRequest) Reset() {
+	*x = DamageRequest{}
+	mi := &file_proto_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DamageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageRequest) ProtoMessage() {}
+
+func (x *DamageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageRequest.ProtoReflect.Descriptor instead.
+func (*DamageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DamageRequest) GetAttacker() string {
+	if x != nil {
+		return x.Attacker
+	}
+	return ""
+}
+
+func (x *DamageRequest) GetDefender() string {
+	if x != nil {
+		return x.Defender
+	}
+	return ""
+}
+
+func (x *DamageRequest) GetMove() string {
+	if x != nil {
+		return x.Move
+	}
+	return ""
+}
+
+func (x *DamageRequest) GetAttackerLevel() int32 {
+	if x != nil {
+		return x.AttackerLevel
+	}
+	return 0
+}
+
+func (x *DamageRequest) GetDefenderLevel() int32 {
+	if x != nil {
+		return x.DefenderLevel
+	}
+	return 0
+}
+
+func (x *DamageRequest) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *DamageRequest) GetWeather() string {
+	if x != nil {
+		return x.Weather
+	}
+	return ""
+}
+
+func (x *DamageRequest) GetOtherModifier() float64 {
+	if x != nil {
+		return x.OtherModifier
+	}
+	return 0
+}
+
+type DamageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Move          *Move                  `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	MinDamage     int32                  `protobuf:"varint,4,opt,name=min_damage,json=minDamage,proto3" json:"min_damage,omitempty"`
+	MaxDamage     int32                  `protobuf:"varint,5,opt,name=max_damage,json=maxDamage,proto3" json:"max_damage,omitempty"`
+	MinPercent    float64                `protobuf:"fixed64,6,opt,name=min_percent,json=minPercent,proto3" json:"min_percent,omitempty"` // Of the defender's max HP
+	MaxPercent    float64                `protobuf:"fixed64,7,opt,name=max_percent,json=maxPercent,proto3" json:"max_percent,omitempty"`
+	DefenderHp    int32                  `protobuf:"varint,8,opt,name=defender_hp,json=defenderHp,proto3" json:"defender_hp,omitempty"`
+	Rolls         []int32                `protobuf:"varint,9,rep,packed,name=rolls,proto3" json:"rolls,omitempty"` // All 16 damage rolls, lowest first
+	Stab          bool                   `protobuf:"varint,10,opt,name=stab,proto3" json:"stab,omitempty"`
+	Effectiveness float64                `protobuf:"fixed64,11,opt,name=effectiveness,proto3" json:"effectiveness,omitempty"`
+	MinHitsToKo   int32                  `protobuf:"varint,12,opt,name=min_hits_to_ko,json=minHitsToKo,proto3" json:"min_hits_to_ko,omitempty"`
+	MaxHitsToKo   int32                  `protobuf:"varint,13,opt,name=max_hits_to_ko,json=maxHitsToKo,proto3" json:"max_hits_to_ko,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DamageResponse) Reset() {
+	*x = DamageResponse{}
+	mi := &file_proto_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DamageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DamageResponse) ProtoMessage() {}
+
+func (x *DamageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DamageResponse.ProtoReflect.Descriptor instead.
+func (*DamageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DamageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DamageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DamageResponse) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *DamageResponse) GetMinDamage() int32 {
+	if x != nil {
+		return x.MinDamage
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetMaxDamage() int32 {
+	if x != nil {
+		return x.MaxDamage
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetMinPercent() float64 {
+	if x != nil {
+		return x.MinPercent
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetMaxPercent() float64 {
+	if x != nil {
+		return x.MaxPercent
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetDefenderHp() int32 {
+	if x != nil {
+		return x.DefenderHp
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetRolls() []int32 {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *DamageResponse) GetStab() bool {
+	if x != nil {
+		return x.Stab
+	}
+	return false
+}
+
+func (x *DamageResponse) GetEffectiveness() float64 {
+	if x != nil {
+		return x.Effectiveness
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetMinHitsToKo() int32 {
+	if x != nil {
+		return x.MinHitsToKo
+	}
+	return 0
+}
+
+func (x *DamageResponse) GetMaxHitsToKo() int32 {
+	if x != nil {
+		return x.MaxHitsToKo
+	}
+	return 0
+}
+
+type WarmCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromId        int32                  `protobuf:"varint,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"` // Inclusive ID range, used when names is empty
+	ToId          int32                  `protobuf:"varint,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Names         []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Concurrency   int32                  `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"` // Defaults to 8
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheRequest) Reset() {
+	*x = WarmCacheRequest{}
+	mi := &file_proto_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheRequest) ProtoMessage() {}
+
+func (x *WarmCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheRequest.ProtoReflect.Descriptor instead.
+func (*WarmCacheRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WarmCacheRequest) GetFromId() int32 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *WarmCacheRequest) GetToId() int32 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *WarmCacheRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *WarmCacheRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type WarmCacheProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Fetched       int32                  `protobuf:"varint,2,opt,name=fetched,proto3" json:"fetched,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"` // Already cached
+	ElapsedMs     int64                  `protobuf:"varint,5,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	EtaMs         int64                  `protobuf:"varint,6,opt,name=eta_ms,json=etaMs,proto3" json:"eta_ms,omitempty"`
+	LastQuery     string                 `protobuf:"bytes,7,opt,name=last_query,json=lastQuery,proto3" json:"last_query,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Done          bool                   `protobuf:"varint,9,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheProgress) Reset() {
+	*x = WarmCacheProgress{}
+	mi := &file_proto_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheProgress) ProtoMessage() {}
+
+func (x *WarmCacheProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheProgress.ProtoReflect.Descriptor instead.
+func (*WarmCacheProgress) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WarmCacheProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetFetched() int32 {
+	if x != nil {
+		return x.Fetched
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetEtaMs() int64 {
+	if x != nil {
+		return x.EtaMs
+	}
+	return 0
+}
+
+func (x *WarmCacheProgress) GetLastQuery() string {
+	if x != nil {
+		return x.LastQuery
+	}
+	return ""
+}
+
+func (x *WarmCacheProgress) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WarmCacheProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type PokedexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromId        int32                  `protobuf:"varint,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"` // Inclusive ID range, ignored when all is set
+	ToId          int32                  `protobuf:"varint,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	All           bool                   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"` // Stream the whole National Pokedex
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PokedexRequest) Reset() {
+	*x = PokedexRequest{}
+	mi := &file_proto_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PokedexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PokedexRequest) ProtoMessage() {}
+
+func (x *PokedexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PokedexRequest.ProtoReflect.Descriptor instead.
+func (*PokedexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PokedexRequest) GetFromId() int32 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *PokedexRequest) GetToId() int32 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *PokedexRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type PokemonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []string               `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"` // Up to 50 IDs or names
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PokemonsRequest) Reset() {
+	*x = PokemonsRequest{}
+	mi := &file_proto_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PokemonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PokemonsRequest) ProtoMessage() {}
+
+func (x *PokemonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PokemonsRequest.ProtoReflect.Descriptor instead.
+func (*PokemonsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PokemonsRequest) GetQueries() []string {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type PokemonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*PokemonResponse     `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"` // One per query, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PokemonsResponse) Reset() {
+	*x = PokemonsResponse{}
+	mi := &file_proto_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PokemonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PokemonsResponse) ProtoMessage() {}
+
+func (x *PokemonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PokemonsResponse.ProtoReflect.Descriptor instead.
+func (*PokemonsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PokemonsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PokemonsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PokemonsResponse) GetResults() []*PokemonResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type FlavorTextSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`  // e.g. "stores electricity in its cheeks"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlavorTextSearchRequest) Reset() {
+	*x = FlavorTextSearchRequest{}
+	mi := &file_proto_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlavorTextSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlavorTextSearchRequest) ProtoMessage() {}
+
+func (x *FlavorTextSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlavorTextSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlavorTextSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FlavorTextSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FlavorTextSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FlavorTextSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hits          []*FlavorTextHit       `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"` // Best match first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlavorTextSearchResponse) Reset() {
+	*x = FlavorTextSearchResponse{}
+	mi := &file_proto_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlavorTextSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlavorTextSearchResponse) ProtoMessage() {}
+
+func (x *FlavorTextSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlavorTextSearchResponse.ProtoReflect.Descriptor instead.
+func (*FlavorTextSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FlavorTextSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FlavorTextSearchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FlavorTextSearchResponse) GetHits() []*FlavorTextHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type FlavorTextHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Snippet       string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"` // The Pokedex entry that matched best
+	Highlights    []*Highlight           `protobuf:"bytes,5,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	Versions      []string               `protobuf:"bytes,6,rep,name=versions,proto3" json:"versions,omitempty"` // Games that use this entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlavorTextHit) Reset() {
+	*x = FlavorTextHit{}
+	mi := &file_proto_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlavorTextHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlavorTextHit) ProtoMessage() {}
+
+func (x *FlavorTextHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlavorTextHit.ProtoReflect.Descriptor instead.
+func (*FlavorTextHit) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FlavorTextHit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FlavorTextHit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlavorTextHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FlavorTextHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *FlavorTextHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *FlavorTextHit) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// Character offsets of a matched word in a snippet, end exclusive
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_proto_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Highlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Highlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type EncounterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`     // Pokemon ID or name
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Optional game version, e.g. "sword" or "heartgold"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncounterRequest) Reset() {
+	*x = EncounterRequest{}
+	mi := &file_proto_game_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncounterRequest) ProtoMessage() {}
+
+func (x *EncounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncounterRequest.ProtoReflect.Descriptor instead.
+func (*EncounterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EncounterRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *EncounterRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type EncounterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Versions      []*VersionEncounters   `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty"` // In release order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncounterResponse) Reset() {
+	*x = EncounterResponse{}
+	mi := &file_proto_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncounterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncounterResponse) ProtoMessage() {}
+
+func (x *EncounterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncounterResponse.ProtoReflect.Descriptor instead.
+func (*EncounterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EncounterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EncounterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EncounterResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EncounterResponse) GetVersions() []*VersionEncounters {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type VersionEncounters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Encounters    []*Encounter           `protobuf:"bytes,2,rep,name=encounters,proto3" json:"encounters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionEncounters) Reset() {
+	*x = VersionEncounters{}
+	mi := &file_proto_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionEncounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionEncounters) ProtoMessage() {}
+
+func (x *VersionEncounters) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionEncounters.ProtoReflect.Descriptor instead.
+func (*VersionEncounters) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VersionEncounters) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionEncounters) GetEncounters() []*Encounter {
+	if x != nil {
+		return x.Encounters
+	}
+	return nil
+}
+
+type Encounter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"` // e.g. "walk", "surf", "old-rod", "good-rod", "super-rod", "gift"
+	MinLevel      int32                  `protobuf:"varint,3,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel      int32                  `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	Chance        int32                  `protobuf:"varint,5,opt,name=chance,proto3" json:"chance,omitempty"`        // Percent
+	Conditions    []string               `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions,omitempty"` // e.g. "time-night", "season-spring"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Encounter) Reset() {
+	*x = Encounter{}
+	mi := &file_proto_game_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Encounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Encounter) ProtoMessage() {}
+
+func (x *Encounter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Encounter.ProtoReflect.Descriptor instead.
+func (*Encounter) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Encounter) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *Encounter) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Encounter) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *Encounter) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *Encounter) GetChance() int32 {
+	if x != nil {
+		return x.Chance
+	}
+	return 0
+}
+
+func (x *Encounter) GetConditions() []string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                        // e.g. "healing", "standard-balls"
+	Cost          int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`                               // Poke Dollars, 0 when it can't be bought
+	FlingPower    int32                  `protobuf:"varint,5,opt,name=fling_power,json=flingPower,proto3" json:"fling_power,omitempty"` // 0 when it can't be flung
+	FlingEffect   string                 `protobuf:"bytes,6,opt,name=fling_effect,json=flingEffect,proto3" json:"fling_effect,omitempty"`
+	Effect        string                 `protobuf:"bytes,7,opt,name=effect,proto3" json:"effect,omitempty"`
+	ShortEffect   string                 `protobuf:"bytes,8,opt,name=short_effect,json=shortEffect,proto3" json:"short_effect,omitempty"`
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"` // Latest in-game description
+	Sprite        string                 `protobuf:"bytes,10,opt,name=sprite,proto3" json:"sprite,omitempty"`
+	HeldBy        []*ItemHolder          `protobuf:"bytes,11,rep,name=held_by,json=heldBy,proto3" json:"held_by,omitempty"` // Wild Pokemon that may hold it
+	Berry         *Berry                 `protobuf:"bytes,12,opt,name=berry,proto3" json:"berry,omitempty"`                 // Only set for berries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_proto_game_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Item) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Item) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Item) GetFlingPower() int32 {
+	if x != nil {
+		return x.FlingPower
+	}
+	return 0
+}
+
+func (x *Item) GetFlingEffect() string {
+	if x != nil {
+		return x.FlingEffect
+	}
+	return ""
+}
+
+func (x *Item) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Item) GetShortEffect() string {
+	if x != nil {
+		return x.ShortEffect
+	}
+	return ""
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Item) GetSprite() string {
+	if x != nil {
+		return x.Sprite
+	}
+	return ""
+}
+
+func (x *Item) GetHeldBy() []*ItemHolder {
+	if x != nil {
+		return x.HeldBy
+	}
+	return nil
+}
+
+func (x *Item) GetBerry() *Berry {
+	if x != nil {
+		return x.Berry
+	}
+	return nil
+}
+
+type ItemHolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pokemon       string                 `protobuf:"bytes,1,opt,name=pokemon,proto3" json:"pokemon,omitempty"`
+	Versions      []*ItemRarity          `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemHolder) Reset() {
+	*x = ItemHolder{}
+	mi := &file_proto_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemHolder) ProtoMessage() {}
+
+func (x *ItemHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemHolder.ProtoReflect.Descriptor instead.
+func (*ItemHolder) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ItemHolder) GetPokemon() string {
+	if x != nil {
+		return x.Pokemon
+	}
+	return ""
+}
+
+func (x *ItemHolder) GetVersions() []*ItemRarity {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ItemRarity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Rarity        int32                  `protobuf:"varint,2,opt,name=rarity,proto3" json:"rarity,omitempty"` // Percent chance the Pokemon holds it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemRarity) Reset() {
+	*x = ItemRarity{}
+	mi := &file_proto_game_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemRarity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemRarity) ProtoMessage() {}
+
+func (x *ItemRarity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemRarity.ProtoReflect.Descriptor instead.
+func (*ItemRarity) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ItemRarity) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ItemRarity) GetRarity() int32 {
+	if x != nil {
+		return x.Rarity
+	}
+	return 0
+}
+
+type Berry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Firmness         string                 `protobuf:"bytes,1,opt,name=firmness,proto3" json:"firmness,omitempty"`
+	GrowthTime       int32                  `protobuf:"varint,2,opt,name=growth_time,json=growthTime,proto3" json:"growth_time,omitempty"` // Hours per growth stage
+	MaxHarvest       int32                  `protobuf:"varint,3,opt,name=max_harvest,json=maxHarvest,proto3" json:"max_harvest,omitempty"`
+	NaturalGiftPower int32                  `protobuf:"varint,4,opt,name=natural_gift_power,json=naturalGiftPower,proto3" json:"natural_gift_power,omitempty"`
+	NaturalGiftType  string                 `protobuf:"bytes,5,opt,name=natural_gift_type,json=naturalGiftType,proto3" json:"natural_gift_type,omitempty"`
+	Size             int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"` // Millimeters
+	Smoothness       int32                  `protobuf:"varint,7,opt,name=smoothness,proto3" json:"smoothness,omitempty"`
+	SoilDryness      int32                  `protobuf:"varint,8,opt,name=soil_dryness,json=soilDryness,proto3" json:"soil_dryness,omitempty"`
+	Flavors          []*BerryFlavor         `protobuf:"bytes,9,rep,name=flavors,proto3" json:"flavors,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Berry) Reset() {
+	*x = Berry{}
+	mi := &file_proto_game_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Berry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Berry) ProtoMessage() {}
+
+func (x *Berry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Berry.ProtoReflect.Descriptor instead.
+func (*Berry) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Berry) GetFirmness() string {
+	if x != nil {
+		return x.Firmness
+	}
+	return ""
+}
+
+func (x *Berry) GetGrowthTime() int32 {
+	if x != nil {
+		return x.GrowthTime
+	}
+	return 0
+}
+
+func (x *Berry) GetMaxHarvest() int32 {
+	if x != nil {
+		return x.MaxHarvest
+	}
+	return 0
+}
+
+func (x *Berry) GetNaturalGiftPower() int32 {
+	if x != nil {
+		return x.NaturalGiftPower
+	}
+	return 0
+}
+
+func (x *Berry) GetNaturalGiftType() string {
+	if x != nil {
+		return x.NaturalGiftType
+	}
+	return ""
+}
+
+func (x *Berry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Berry) GetSmoothness() int32 {
+	if x != nil {
+		return x.Smoothness
+	}
+	return 0
+}
+
+func (x *Berry) GetSoilDryness() int32 {
+	if x != nil {
+		return x.SoilDryness
+	}
+	return 0
+}
+
+func (x *Berry) GetFlavors() []*BerryFlavor {
+	if x != nil {
+		return x.Flavors
+	}
+	return nil
+}
+
+type BerryFlavor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flavor        string                 `protobuf:"bytes,1,opt,name=flavor,proto3" json:"flavor,omitempty"` // "spicy", "dry", "sweet", "bitter" or "sour"
+	Potency       int32                  `protobuf:"varint,2,opt,name=potency,proto3" json:"potency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BerryFlavor) Reset() {
+	*x = BerryFlavor{}
+	mi := &file_proto_game_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BerryFlavor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BerryFlavor) ProtoMessage() {}
+
+func (x *BerryFlavor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BerryFlavor.ProtoReflect.Descriptor instead.
+func (*BerryFlavor) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BerryFlavor) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+func (x *BerryFlavor) GetPotency() int32 {
+	if x != nil {
+		return x.Potency
+	}
+	return 0
+}
+
+type ItemSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sprite        string                 `protobuf:"bytes,3,opt,name=sprite,proto3" json:"sprite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSummary) Reset() {
+	*x = ItemSummary{}
+	mi := &file_proto_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSummary) ProtoMessage() {}
+
+func (x *ItemSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSummary.ProtoReflect.Descriptor instead.
+func (*ItemSummary) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ItemSummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ItemSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ItemSummary) GetSprite() string {
+	if x != nil {
+		return x.Sprite
+	}
+	return ""
+}
+
+type ItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Can be ID (e.g., "1") or name (e.g., "master-ball", "Oran Berry", "oran")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemRequest) Reset() {
+	*x = ItemRequest{}
+	mi := &file_proto_game_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemRequest) ProtoMessage() {}
+
+func (x *ItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemRequest.ProtoReflect.Descriptor instead.
+func (*ItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ItemRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item          *Item                  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemResponse) Reset() {
+	*x = ItemResponse{}
+	mi := &file_proto_game_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemResponse) ProtoMessage() {}
+
+func (x *ItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemResponse.ProtoReflect.Descriptor instead.
+func (*ItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ItemSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSearchRequest) Reset() {
+	*x = ItemSearchRequest{}
+	mi := &file_proto_game_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSearchRequest) ProtoMessage() {}
+
+func (x *ItemSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSearchRequest.ProtoReflect.Descriptor instead.
+func (*ItemSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ItemSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ItemSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ItemSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items         []*ItemSummary         `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`  // Exact and prefix matches first
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"` // Matches before the limit was applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSearchResponse) Reset() {
+	*x = ItemSearchResponse{}
+	mi := &file_proto_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSearchResponse) ProtoMessage() {}
+
+func (x *ItemSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSearchResponse.ProtoReflect.Descriptor instead.
+func (*ItemSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ItemSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ItemSearchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ItemSearchResponse) GetItems() []*ItemSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ItemSearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ItemCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Item category like "standard-balls" or pocket like "berries"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemCategoryRequest) Reset() {
+	*x = ItemCategoryRequest{}
+	mi := &file_proto_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemCategoryRequest) ProtoMessage() {}
+
+func (x *ItemCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ItemCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ItemCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ItemListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items         []*ItemSummary         `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // By ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemListResponse) Reset() {
+	*x = ItemListResponse{}
+	mi := &file_proto_game_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemListResponse) ProtoMessage() {}
+
+func (x *ItemListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemListResponse.ProtoReflect.Descriptor instead.
+func (*ItemListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ItemListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ItemListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ItemListResponse) GetItems() []*ItemSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // "hour", "day" or "week", defaults to "day"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingRequest) Reset() {
+	*x = TrendingRequest{}
+	mi := &file_proto_game_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingRequest) ProtoMessage() {}
+
+func (x *TrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingRequest.ProtoReflect.Descriptor instead.
+func (*TrendingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TrendingRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *TrendingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrendingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Window        string                 `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	Pokemon       []*TrendingPokemon     `protobuf:"bytes,4,rep,name=pokemon,proto3" json:"pokemon,omitempty"` // Most looked up first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingResponse) Reset() {
+	*x = TrendingResponse{}
+	mi := &file_proto_game_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingResponse) ProtoMessage() {}
+
+func (x *TrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingResponse.ProtoReflect.Descriptor instead.
+func (*TrendingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TrendingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TrendingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TrendingResponse) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *TrendingResponse) GetPokemon() []*TrendingPokemon {
+	if x != nil {
+		return x.Pokemon
+	}
+	return nil
+}
+
+type TrendingPokemon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Lookups       int32                  `protobuf:"varint,4,opt,name=lookups,proto3" json:"lookups,omitempty"`
+	PreviousRank  int32                  `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"` // Rank in the window before, 0 when new
+	Movement      int32                  `protobuf:"varint,6,opt,name=movement,proto3" json:"movement,omitempty"`                             // Places climbed since the window before, negative when it fell
+	New           bool                   `protobuf:"varint,7,opt,name=new,proto3" json:"new,omitempty"`                                       // Not looked up at all in the window before
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingPokemon) Reset() {
+	*x = TrendingPokemon{}
+	mi := &file_proto_game_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingPokemon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingPokemon) ProtoMessage() {}
+
+func (x *TrendingPokemon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingPokemon.ProtoReflect.Descriptor instead.
+func (*TrendingPokemon) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TrendingPokemon) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TrendingPokemon) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrendingPokemon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingPokemon) GetLookups() int32 {
+	if x != nil {
+		return x.Lookups
+	}
+	return 0
+}
+
+func (x *TrendingPokemon) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *TrendingPokemon) GetMovement() int32 {
+	if x != nil {
+		return x.Movement
+	}
+	return 0
+}
+
+func (x *TrendingPokemon) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+type BreedingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         string                 `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"` // Pokemon ID or name
+	Second        string                 `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreedingRequest) Reset() {
+	*x = BreedingRequest{}
+	mi := &file_proto_game_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedingRequest) ProtoMessage() {}
+
+func (x *BreedingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedingRequest.ProtoReflect.Descriptor instead.
+func (*BreedingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BreedingRequest) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *BreedingRequest) GetSecond() string {
+	if x != nil {
+		return x.Second
+	}
+	return ""
+}
+
+type BreedingResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Compatible      bool                   `protobuf:"varint,3,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	Parents         []*BreedingParent      `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`
+	SharedEggGroups []string               `protobuf:"bytes,5,rep,name=shared_egg_groups,json=sharedEggGroups,proto3" json:"shared_egg_groups,omitempty"`
+	Offspring       []*BreedingOffspring   `protobuf:"bytes,6,rep,name=offspring,proto3" json:"offspring,omitempty"` // Only set when compatible
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BreedingResponse) Reset() {
+	*x = BreedingResponse{}
+	mi := &file_proto_game_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedingResponse) ProtoMessage() {}
+
+func (x *BreedingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedingResponse.ProtoReflect.Descriptor instead.
+func (*BreedingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BreedingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BreedingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BreedingResponse) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
+}
+
+func (x *BreedingResponse) GetParents() []*BreedingParent {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *BreedingResponse) GetSharedEggGroups() []string {
+	if x != nil {
+		return x.SharedEggGroups
+	}
+	return nil
+}
+
+func (x *BreedingResponse) GetOffspring() []*BreedingOffspring {
+	if x != nil {
+		return x.Offspring
+	}
+	return nil
+}
+
+type BreedingParent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Species name
+	EggGroups     []string               `protobuf:"bytes,2,rep,name=egg_groups,json=eggGroups,proto3" json:"egg_groups,omitempty"` // e.g. "monster", "field", "no-eggs" (Undiscovered)
+	Genderless    bool                   `protobuf:"varint,3,opt,name=genderless,proto3" json:"genderless,omitempty"`
+	FemalePercent float64                `protobuf:"fixed64,4,opt,name=female_percent,json=femalePercent,proto3" json:"female_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreedingParent) Reset() {
+	*x = BreedingParent{}
+	mi := &file_proto_game_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedingParent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedingParent) ProtoMessage() {}
+
+func (x *BreedingParent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedingParent.ProtoReflect.Descriptor instead.
+func (*BreedingParent) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BreedingParent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BreedingParent) GetEggGroups() []string {
+	if x != nil {
+		return x.EggGroups
+	}
+	return nil
+}
+
+func (x *BreedingParent) GetGenderless() bool {
+	if x != nil {
+		return x.Genderless
+	}
+	return false
+}
+
+func (x *BreedingParent) GetFemalePercent() float64 {
+	if x != nil {
+		return x.FemalePercent
+	}
+	return 0
+}
+
+type BreedingOffspring struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       string                 `protobuf:"bytes,1,opt,name=species,proto3" json:"species,omitempty"`
+	Mother        string                 `protobuf:"bytes,2,opt,name=mother,proto3" json:"mother,omitempty"`   // The parent whose species hatches
+	Incense       string                 `protobuf:"bytes,3,opt,name=incense,proto3" json:"incense,omitempty"` // Held item needed to hatch this baby form
+	EggCycles     int32                  `protobuf:"varint,4,opt,name=egg_cycles,json=eggCycles,proto3" json:"egg_cycles,omitempty"`
+	HatchSteps    int32                  `protobuf:"varint,5,opt,name=hatch_steps,json=hatchSteps,proto3" json:"hatch_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreedingOffspring) Reset() {
+	*x = BreedingOffspring{}
+	mi := &file_proto_game_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedingOffspring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedingOffspring) ProtoMessage() {}
+
+func (x *BreedingOffspring) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedingOffspring.ProtoReflect.Descriptor instead.
+func (*BreedingOffspring) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BreedingOffspring) GetSpecies() string {
+	if x != nil {
+		return x.Species
+	}
+	return ""
+}
+
+func (x *BreedingOffspring) GetMother() string {
+	if x != nil {
+		return x.Mother
+	}
+	return ""
+}
+
+func (x *BreedingOffspring) GetIncense() string {
+	if x != nil {
+		return x.Incense
+	}
+	return ""
+}
+
+func (x *BreedingOffspring) GetEggCycles() int32 {
+	if x != nil {
+		return x.EggCycles
+	}
+	return 0
+}
+
+func (x *BreedingOffspring) GetHatchSteps() int32 {
+	if x != nil {
+		return x.HatchSteps
+	}
+	return 0
+}
+
+// A value for each stat, used for IVs, EVs and observed stats
+type StatSpread struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hp             int32                  `protobuf:"varint,1,opt,name=hp,proto3" json:"hp,omitempty"`
+	Attack         int32                  `protobuf:"varint,2,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defense        int32                  `protobuf:"varint,3,opt,name=defense,proto3" json:"defense,omitempty"`
+	SpecialAttack  int32                  `protobuf:"varint,4,opt,name=special_attack,json=specialAttack,proto3" json:"special_attack,omitempty"`
+	SpecialDefense int32                  `protobuf:"varint,5,opt,name=special_defense,json=specialDefense,proto3" json:"special_defense,omitempty"`
+	Speed          int32                  `protobuf:"varint,6,opt,name=speed,proto3" json:"speed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatSpread) Reset() {
+	*x = StatSpread{}
+	mi := &file_proto_game_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatSpread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatSpread) ProtoMessage() {}
+
+func (x *StatSpread) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatSpread.ProtoReflect.Descriptor instead.
+func (*StatSpread) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StatSpread) GetHp() int32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+func (x *StatSpread) GetAttack() int32 {
+	if x != nil {
+		return x.Attack
+	}
+	return 0
+}
+
+func (x *StatSpread) GetDefense() int32 {
+	if x != nil {
+		return x.Defense
+	}
+	return 0
+}
+
+func (x *StatSpread) GetSpecialAttack() int32 {
+	if x != nil {
+		return x.SpecialAttack
+	}
+	return 0
+}
+
+func (x *StatSpread) GetSpecialDefense() int32 {
+	if x != nil {
+		return x.SpecialDefense
+	}
+	return 0
+}
+
+func (x *StatSpread) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type StatCalcRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`   // Pokemon ID or name
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`  // 1-100, defaults to 50
+	Nature        string                 `protobuf:"bytes,3,opt,name=nature,proto3" json:"nature,omitempty"` // e.g. "jolly", defaults to a neutral nature
+	Ivs           *StatSpread            `protobuf:"bytes,4,opt,name=ivs,proto3" json:"ivs,omitempty"`       // 0-31 each, defaults to 31 in every stat when unset
+	Evs           *StatSpread            `protobuf:"bytes,5,opt,name=evs,proto3" json:"evs,omitempty"`       // 0-252 each and 510 in total, defaults to 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatCalcRequest) Reset() {
+	*x = StatCalcRequest{}
+	mi := &file_proto_game_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatCalcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatCalcRequest) ProtoMessage() {}
+
+func (x *StatCalcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatCalcRequest.ProtoReflect.Descriptor instead.
+func (*StatCalcRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StatCalcRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *StatCalcRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *StatCalcRequest) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *StatCalcRequest) GetIvs() *StatSpread {
+	if x != nil {
+		return x.Ivs
+	}
+	return nil
+}
+
+func (x *StatCalcRequest) GetEvs() *StatSpread {
+	if x != nil {
+		return x.Evs
+	}
+	return nil
+}
+
+type StatCalcResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Pokemon       string                 `protobuf:"bytes,3,opt,name=pokemon,proto3" json:"pokemon,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Nature        string                 `protobuf:"bytes,5,opt,name=nature,proto3" json:"nature,omitempty"`
+	Stats         []*CalculatedStat      `protobuf:"bytes,6,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatCalcResponse) Reset() {
+	*x = StatCalcResponse{}
+	mi := &file_proto_game_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatCalcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatCalcResponse) ProtoMessage() {}
+
+func (x *StatCalcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatCalcResponse.ProtoReflect.Descriptor instead.
+func (*StatCalcResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StatCalcResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StatCalcResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StatCalcResponse) GetPokemon() string {
+	if x != nil {
+		return x.Pokemon
+	}
+	return ""
+}
+
+func (x *StatCalcResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *StatCalcResponse) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *StatCalcResponse) GetStats() []*CalculatedStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CalculatedStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Base          int32                  `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Iv            int32                  `protobuf:"varint,3,opt,name=iv,proto3" json:"iv,omitempty"`
+	Ev            int32                  `protobuf:"varint,4,opt,name=ev,proto3" json:"ev,omitempty"`
+	Value         int32                  `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	NaturePercent int32                  `protobuf:"varint,6,opt,name=nature_percent,json=naturePercent,proto3" json:"nature_percent,omitempty"` // 110, 100 or 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatedStat) Reset() {
+	*x = CalculatedStat{}
+	mi := &file_proto_game_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatedStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatedStat) ProtoMessage() {}
+
+func (x *CalculatedStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatedStat.ProtoReflect.Descriptor instead.
+func (*CalculatedStat) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CalculatedStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalculatedStat) GetBase() int32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *CalculatedStat) GetIv() int32 {
+	if x != nil {
+		return x.Iv
+	}
+	return 0
+}
+
+func (x *CalculatedStat) GetEv() int32 {
+	if x != nil {
+		return x.Ev
+	}
+	return 0
+}
+
+func (x *CalculatedStat) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CalculatedStat) GetNaturePercent() int32 {
+	if x != nil {
+		return x.NaturePercent
+	}
+	return 0
+}
+
+type IVEstimateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`   // Pokemon ID or name
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`  // 1-100, defaults to 50
+	Nature        string                 `protobuf:"bytes,3,opt,name=nature,proto3" json:"nature,omitempty"` // Defaults to a neutral nature
+	Stats         *StatSpread            `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`   // The stats the Pokemon has in game
+	Evs           *StatSpread            `protobuf:"bytes,5,opt,name=evs,proto3" json:"evs,omitempty"`       // Defaults to 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IVEstimateRequest) Reset() {
+	*x = IVEstimateRequest{}
+	mi := &file_proto_game_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IVEstimateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IVEstimateRequest) ProtoMessage() {}
+
+func (x *IVEstimateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IVEstimateRequest.ProtoReflect.Descriptor instead.
+func (*IVEstimateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *IVEstimateRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *IVEstimateRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *IVEstimateRequest) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *IVEstimateRequest) GetStats() *StatSpread {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *IVEstimateRequest) GetEvs() *StatSpread {
+	if x != nil {
+		return x.Evs
+	}
+	return nil
+}
+
+type IVEstimateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Pokemon       string                 `protobuf:"bytes,3,opt,name=pokemon,proto3" json:"pokemon,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Nature        string                 `protobuf:"bytes,5,opt,name=nature,proto3" json:"nature,omitempty"`
+	Ivs           []*IVRange             `protobuf:"bytes,6,rep,name=ivs,proto3" json:"ivs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IVEstimateResponse) Reset() {
+	*x = IVEstimateResponse{}
+	mi := &file_proto_game_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IVEstimateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IVEstimateResponse) ProtoMessage() {}
+
+func (x *IVEstimateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IVEstimateResponse.ProtoReflect.Descriptor instead.
+func (*IVEstimateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *IVEstimateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IVEstimateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IVEstimateResponse) GetPokemon() string {
+	if x != nil {
+		return x.Pokemon
+	}
+	return ""
+}
+
+func (x *IVEstimateResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *IVEstimateResponse) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *IVEstimateResponse) GetIvs() []*IVRange {
+	if x != nil {
+		return x.Ivs
+	}
+	return nil
+}
+
+type IVRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinIv         int32                  `protobuf:"varint,2,opt,name=min_iv,json=minIv,proto3" json:"min_iv,omitempty"`
+	MaxIv         int32                  `protobuf:"varint,3,opt,name=max_iv,json=maxIv,proto3" json:"max_iv,omitempty"`
+	Possible      bool                   `protobuf:"varint,4,opt,name=possible,proto3" json:"possible,omitempty"` // False when no IV gives the observed stat
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IVRange) Reset() {
+	*x = IVRange{}
+	mi := &file_proto_game_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IVRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IVRange) ProtoMessage() {}
+
+func (x *IVRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IVRange.ProtoReflect.Descriptor instead.
+func (*IVRange) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *IVRange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IVRange) GetMinIv() int32 {
+	if x != nil {
+		return x.MinIv
+	}
+	return 0
+}
+
+func (x *IVRange) GetMaxIv() int32 {
+	if x != nil {
+		return x.MaxIv
+	}
+	return 0
+}
+
+func (x *IVRange) GetPossible() bool {
+	if x != nil {
+		return x.Possible
+	}
+	return false
+}
+
+type ExportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // "csv", "jsonl" or "parquet", defaults to "csv"
+	// Columns to include, in order, defaults to all. Columns follow the Pokemon
+	// message, with one column per stat (e.g. "special_attack"), then species
+	// columns: species, generation, color, shape, habitat, egg_groups,
+	// gender_rate, capture_rate, base_happiness, hatch_counter, is_baby,
+	// is_legendary and is_mythical.
+	Columns       []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	FromId        int32    `protobuf:"varint,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"` // Inclusive ID range, defaults to the whole National Pokedex
+	ToId          int32    `protobuf:"varint,4,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	Generation    int32    `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"` // Only export this generation
+	Types         []string `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`            // Only export Pokemon with any of these types
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_proto_game_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetFromId() int32 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *ExportRequest) GetToId() int32 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+func (x *ExportRequest) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ExportRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // Concatenate every chunk's data to get the file
+	Rows          int32                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`                                 // Rows written so far
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // Only set on the first chunk
+	Columns       []string               `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                            // Only set on the first chunk
+	Done          bool                   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`                                 // Set on the last chunk
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_proto_game_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_game_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+var File_proto_game_proto protoreflect.FileDescriptor
+
+const file_proto_game_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/game.proto\x12\apokemon\":\n" +
+	"\x0ePokemonRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04form\x18\x02 \x01(\tR\x04form\"\x93\x01\n" +
+	"\x0fPokemonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\apokemon\x18\x03 \x01(\v2\x10.pokemon.PokemonR\apokemon\x12 \n" +
+	"\vsuggestions\x18\x04 \x03(\tR\vsuggestions\"\xb5\x01\n" +
+	"\aPokemon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\x12#\n" +
+	"\x05stats\x18\a \x03(\v2\r.pokemon.StatR\x05stats\"7\n" +
+	"\x04Stat\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tbase_stat\x18\x02 \x01(\x05R\bbaseStat\";\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"<\n" +
+	"\x0eSearchResponse\x12*\n" +
+	"\aresults\x18\x01 \x03(\v2\x10.pokemon.PokemonR\aresults\"*\n" +
+	"\x0eCompareRequest\x12\x18\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\"\xac\x02\n" +
+	"\x0fCompareResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\apokemon\x18\x03 \x03(\v2\x10.pokemon.PokemonR\apokemon\x12,\n" +
+	"\x05stats\x18\x04 \x03(\v2\x16.pokemon.ComparisonRowR\x05stats\x12:\n" +
+	"\fmeasurements\x18\x05 \x03(\v2\x16.pokemon.ComparisonRowR\fmeasurements\x12!\n" +
+	"\fshared_types\x18\x06 \x03(\tR\vsharedTypes\x12,\n" +
+	"\bmatchups\x18\a \x03(\v2\x10.pokemon.MatchupR\bmatchups\"r\n" +
+	"\rComparisonRow\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x05R\x06values\x12\x16\n" +
+	"\x06deltas\x18\x03 \x03(\x05R\x06deltas\x12\x1d\n" +
+	"\n" +
+	"best_index\x18\x04 \x01(\x05R\tbestIndex\"\xbb\x01\n" +
+	"\aMatchup\x12%\n" +
+	"\x0eattacker_index\x18\x01 \x01(\x05R\rattackerIndex\x12%\n" +
+	"\x0edefender_index\x18\x02 \x01(\x05R\rdefenderIndex\x129\n" +
+	"\vmultipliers\x18\x03 \x03(\v2\x17.pokemon.TypeMultiplierR\vmultipliers\x12'\n" +
+	"\x0fbest_multiplier\x18\x04 \x01(\x01R\x0ebestMultiplier\"D\n" +
+	"\x0eTypeMultiplier\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\"\xd8\x01\n" +
+	"\vQuizRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rounds\x18\x02 \x01(\x05R\x06rounds\x12%\n" +
+	"\x0emin_generation\x18\x03 \x01(\x05R\rminGeneration\x12%\n" +
+	"\x0emax_generation\x18\x04 \x01(\x05R\rmaxGeneration\x122\n" +
+	"\x15hint_interval_seconds\x18\x05 \x01(\x05R\x13hintIntervalSeconds\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x03R\x04seed\"\x9f\x02\n" +
+	"\tQuizEvent\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12:\n" +
+	"\vround_start\x18\x03 \x01(\v2\x17.pokemon.QuizRoundStartH\x00R\n" +
+	"roundStart\x12'\n" +
+	"\x04hint\x18\x04 \x01(\v2\x11.pokemon.QuizHintH\x00R\x04hint\x12=\n" +
+	"\fround_result\x18\x05 \x01(\v2\x18.pokemon.QuizRoundResultH\x00R\vroundResult\x120\n" +
+	"\asummary\x18\x06 \x01(\v2\x14.pokemon.QuizSummaryH\x00R\asummaryB\a\n" +
+	"\x05event\"Z\n" +
+	"\x0eQuizRoundStart\x12!\n" +
+	"\ftotal_rounds\x18\x01 \x01(\x05R\vtotalRounds\x12%\n" +
+	"\x0esilhouette_url\x18\x02 \x01(\tR\rsilhouetteUrl\"2\n" +
+	"\bQuizHint\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xb8\x01\n" +
+	"\x0fQuizRoundResult\x12(\n" +
+	"\x06answer\x18\x01 \x01(\v2\x10.pokemon.PokemonR\x06answer\x12\x16\n" +
+	"\x06solved\x18\x02 \x01(\bR\x06solved\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x04 \x01(\x03R\telapsedMs\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x16\n" +
+	"\x06streak\x18\x06 \x01(\x05R\x06streak\"\x81\x02\n" +
+	"\vQuizSummary\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12#\n" +
+	"\rsolved_rounds\x18\x03 \x01(\x05R\fsolvedRounds\x12!\n" +
+	"\ftotal_rounds\x18\x04 \x01(\x05R\vtotalRounds\x12\x1f\n" +
+	"\vbest_streak\x18\x05 \x01(\x05R\n" +
+	"bestStreak\x12$\n" +
+	"\x0eround_times_ms\x18\x06 \x03(\x03R\froundTimesMs\x120\n" +
+	"\x06player\x18\a \x01(\v2\x18.pokemon.QuizPlayerStatsR\x06player\"v\n" +
+	"\x0fQuizPlayerStats\x12!\n" +
+	"\fgames_played\x18\x01 \x01(\x05R\vgamesPlayed\x12\x1f\n" +
+	"\vtotal_score\x18\x02 \x01(\x05R\n" +
+	"totalScore\x12\x1f\n" +
+	"\vbest_streak\x18\x03 \x01(\x05R\n" +
+	"bestStreak\"A\n" +
+	"\n" +
+	"QuizAnswer\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05guess\x18\x02 \x01(\tR\x05guess\"\xc7\x01\n" +
+	"\x12QuizAnswerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acorrect\x18\x03 \x01(\bR\acorrect\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x16\n" +
+	"\x06streak\x18\x06 \x01(\x05R\x06streak\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\a \x01(\x03R\telapsedMs\"\xd0\x01\n" +
+	"\x04Move\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05power\x18\x04 \x01(\x05R\x05power\x12\x1a\n" +
+	"\baccuracy\x18\x05 \x01(\x05R\baccuracy\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x0e\n" +
+	"\x02pp\x18\a \x01(\x05R\x02pp\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06effect\x18\t \x01(\tR\x06effect\"#\n" +
+	"\vMoveRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"e\n" +
+	"\fMoveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x04move\x18\x03 \x01(\v2\r.pokemon.MoveR\x04move\"K\n" +
+	"\x0eMovesetRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12#\n" +
+	"\rversion_group\x18\x02 \x01(\tR\fversionGroup\"s\n" +
+	"\x0fMovesetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x05moves\x18\x03 \x03(\v2\x16.pokemon.LearnableMoveR\x05moves\"\x81\x01\n" +
+	"\rLearnableMove\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\flearn_method\x18\x02 \x01(\tR\vlearnMethod\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x12#\n" +
+	"\rversion_group\x18\x04 \x01(\tR\fversionGroup\"\x86\x02\n" +
+	"\rDamageRequest\x12\x1a\n" +
+	"\battacker\x18\x01 \x01(\tR\battacker\x12\x1a\n" +
+	"\bdefender\x18\x02 \x01(\tR\bdefender\x12\x12\n" +
+	"\x04move\x18\x03 \x01(\tR\x04move\x12%\n" +
+	"\x0eattacker_level\x18\x04 \x01(\x05R\rattackerLevel\x12%\n" +
+	"\x0edefender_level\x18\x05 \x01(\x05R\rdefenderLevel\x12\x1a\n" +
+	"\bcritical\x18\x06 \x01(\bR\bcritical\x12\x18\n" +
+	"\aweather\x18\a \x01(\tR\aweather\x12%\n" +
+	"\x0eother_modifier\x18\b \x01(\x01R\rotherModifier\"\xa2\x03\n" +
+	"\x0eDamageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x04move\x18\x03 \x01(\v2\r.pokemon.MoveR\x04move\x12\x1d\n" +
+	"\n" +
+	"min_damage\x18\x04 \x01(\x05R\tminDamage\x12\x1d\n" +
+	"\n" +
+	"max_damage\x18\x05 \x01(\x05R\tmaxDamage\x12\x1f\n" +
+	"\vmin_percent\x18\x06 \x01(\x01R\n" +
+	"minPercent\x12\x1f\n" +
+	"\vmax_percent\x18\a \x01(\x01R\n" +
+	"maxPercent\x12\x1f\n" +
+	"\vdefender_hp\x18\b \x01(\x05R\n" +
+	"defenderHp\x12\x14\n" +
+	"\x05rolls\x18\t \x03(\x05R\x05rolls\x12\x12\n" +
+	"\x04stab\x18\n" +
+	" \x01(\bR\x04stab\x12$\n" +
+	"\reffectiveness\x18\v \x01(\x01R\reffectiveness\x12#\n" +
+	"\x0emin_hits_to_ko\x18\f \x01(\x05R\vminHitsToKo\x12#\n" +
+	"\x0emax_hits_to_ko\x18\r \x01(\x05R\vmaxHitsToKo\"x\n" +
+	"\x10WarmCacheRequest\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\x05R\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\x05R\x04toId\x12\x14\n" +
+	"\x05names\x18\x03 \x03(\tR\x05names\x12 \n" +
+	"\vconcurrency\x18\x04 \x01(\x05R\vconcurrency\"\xfd\x01\n" +
+	"\x11WarmCacheProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\afetched\x18\x02 \x01(\x05R\afetched\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x05 \x01(\x03R\telapsedMs\x12\x15\n" +
+	"\x06eta_ms\x18\x06 \x01(\x03R\x05etaMs\x12\x1d\n" +
+	"\n" +
+	"last_query\x18\a \x01(\tR\tlastQuery\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12\x12\n" +
+	"\x04done\x18\t \x01(\bR\x04done\"P\n" +
+	"\x0ePokedexRequest\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\x05R\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\x05R\x04toId\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\"+\n" +
+	"\x0fPokemonsRequest\x12\x18\n" +
+	"\aqueries\x18\x01 \x03(\tR\aqueries\"z\n" +
+	"\x10PokemonsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\aresults\x18\x03 \x03(\v2\x18.pokemon.PokemonResponseR\aresults\"E\n" +
+	"\x17FlavorTextSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"z\n" +
+	"\x18FlavorTextSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x04hits\x18\x03 \x03(\v2\x16.pokemon.FlavorTextHitR\x04hits\"\xb3\x01\n" +
+	"\rFlavorTextHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x122\n" +
+	"\n" +
+	"highlights\x18\x05 \x03(\v2\x12.pokemon.HighlightR\n" +
+	"highlights\x12\x1a\n" +
+	"\bversions\x18\x06 \x03(\tR\bversions\"3\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"B\n" +
+	"\x10EncounterRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\x93\x01\n" +
+	"\x11EncounterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x126\n" +
+	"\bversions\x18\x04 \x03(\v2\x1a.pokemon.VersionEncountersR\bversions\"a\n" +
+	"\x11VersionEncounters\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x122\n" +
+	"\n" +
+	"encounters\x18\x02 \x03(\v2\x12.pokemon.EncounterR\n" +
+	"encounters\"\xa9\x01\n" +
+	"\tEncounter\x12\x12\n" +
+	"\x04area\x18\x01 \x01(\tR\x04area\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1b\n" +
+	"\tmin_level\x18\x03 \x01(\x05R\bminLevel\x12\x1b\n" +
+	"\tmax_level\x18\x04 \x01(\x05R\bmaxLevel\x12\x16\n" +
+	"\x06chance\x18\x05 \x01(\x05R\x06chance\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\x06 \x03(\tR\n" +
+	"conditions\"\xe7\x02\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x05R\x04cost\x12\x1f\n" +
+	"\vfling_power\x18\x05 \x01(\x05R\n" +
+	"flingPower\x12!\n" +
+	"\ffling_effect\x18\x06 \x01(\tR\vflingEffect\x12\x16\n" +
+	"\x06effect\x18\a \x01(\tR\x06effect\x12!\n" +
+	"\fshort_effect\x18\b \x01(\tR\vshortEffect\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x16\n" +
+	"\x06sprite\x18\n" +
+	" \x01(\tR\x06sprite\x12,\n" +
+	"\aheld_by\x18\v \x03(\v2\x13.pokemon.ItemHolderR\x06heldBy\x12$\n" +
+	"\x05berry\x18\f \x01(\v2\x0e.pokemon.BerryR\x05berry\"W\n" +
+	"\n" +
+	"ItemHolder\x12\x18\n" +
+	"\apokemon\x18\x01 \x01(\tR\apokemon\x12/\n" +
+	"\bversions\x18\x02 \x03(\v2\x13.pokemon.ItemRarityR\bversions\">\n" +
+	"\n" +
+	"ItemRarity\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06rarity\x18\x02 \x01(\x05R\x06rarity\"\xc6\x02\n" +
+	"\x05Berry\x12\x1a\n" +
+	"\bfirmness\x18\x01 \x01(\tR\bfirmness\x12\x1f\n" +
+	"\vgrowth_time\x18\x02 \x01(\x05R\n" +
+	"growthTime\x12\x1f\n" +
+	"\vmax_harvest\x18\x03 \x01(\x05R\n" +
+	"maxHarvest\x12,\n" +
+	"\x12natural_gift_power\x18\x04 \x01(\x05R\x10naturalGiftPower\x12*\n" +
+	"\x11natural_gift_type\x18\x05 \x01(\tR\x0fnaturalGiftType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"smoothness\x18\a \x01(\x05R\n" +
+	"smoothness\x12!\n" +
+	"\fsoil_dryness\x18\b \x01(\x05R\vsoilDryness\x12.\n" +
+	"\aflavors\x18\t \x03(\v2\x14.pokemon.BerryFlavorR\aflavors\"?\n" +
+	"\vBerryFlavor\x12\x16\n" +
+	"\x06flavor\x18\x01 \x01(\tR\x06flavor\x12\x18\n" +
+	"\apotency\x18\x02 \x01(\x05R\apotency\"I\n" +
+	"\vItemSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06sprite\x18\x03 \x01(\tR\x06sprite\"#\n" +
+	"\vItemRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"e\n" +
+	"\fItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\x04item\x18\x03 \x01(\v2\r.pokemon.ItemR\x04item\"?\n" +
+	"\x11ItemSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8a\x01\n" +
+	"\x12ItemSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.pokemon.ItemSummaryR\x05items\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"1\n" +
+	"\x13ItemCategoryRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"r\n" +
+	"\x10ItemListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.pokemon.ItemSummaryR\x05items\"?\n" +
+	"\x0fTrendingRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x92\x01\n" +
+	"\x10TrendingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\tR\x06window\x122\n" +
+	"\apokemon\x18\x04 \x03(\v2\x18.pokemon.TrendingPokemonR\apokemon\"\xb6\x01\n" +
+	"\x0fTrendingPokemon\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\alookups\x18\x04 \x01(\x05R\alookups\x12#\n" +
+	"\rprevious_rank\x18\x05 \x01(\x05R\fpreviousRank\x12\x1a\n" +
+	"\bmovement\x18\x06 \x01(\x05R\bmovement\x12\x10\n" +
+	"\x03new\x18\a \x01(\bR\x03new\"?\n" +
+	"\x0fBreedingRequest\x12\x14\n" +
+	"\x05first\x18\x01 \x01(\tR\x05first\x12\x16\n" +
+	"\x06second\x18\x02 \x01(\tR\x06second\"\xff\x01\n" +
+	"\x10BreedingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"compatible\x18\x03 \x01(\bR\n" +
+	"compatible\x121\n" +
+	"\aparents\x18\x04 \x03(\v2\x17.pokemon.BreedingParentR\aparents\x12*\n" +
+	"\x11shared_egg_groups\x18\x05 \x03(\tR\x0fsharedEggGroups\x128\n" +
+	"\toffspring\x18\x06 \x03(\v2\x1a.pokemon.BreedingOffspringR\toffspring\"\x8a\x01\n" +
+	"\x0eBreedingParent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"egg_groups\x18\x02 \x03(\tR\teggGroups\x12\x1e\n" +
+	"\n" +
+	"genderless\x18\x03 \x01(\bR\n" +
+	"genderless\x12%\n" +
+	"\x0efemale_percent\x18\x04 \x01(\x01R\rfemalePercent\"\x9f\x01\n" +
+	"\x11BreedingOffspring\x12\x18\n" +
+	"\aspecies\x18\x01 \x01(\tR\aspecies\x12\x16\n" +
+	"\x06mother\x18\x02 \x01(\tR\x06mother\x12\x18\n" +
+	"\aincense\x18\x03 \x01(\tR\aincense\x12\x1d\n" +
+	"\n" +
+	"egg_cycles\x18\x04 \x01(\x05R\teggCycles\x12\x1f\n" +
+	"\vhatch_steps\x18\x05 \x01(\x05R\n" +
+	"hatchSteps\"\xb4\x01\n" +
+	"\n" +
+	"StatSpread\x12\x0e\n" +
+	"\x02hp\x18\x01 \x01(\x05R\x02hp\x12\x16\n" +
+	"\x06attack\x18\x02 \x01(\x05R\x06attack\x12\x18\n" +
+	"\adefense\x18\x03 \x01(\x05R\adefense\x12%\n" +
+	"\x0especial_attack\x18\x04 \x01(\x05R\rspecialAttack\x12'\n" +
+	"\x0fspecial_defense\x18\x05 \x01(\x05R\x0especialDefense\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x05R\x05speed\"\xa3\x01\n" +
+	"\x0fStatCalcRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06nature\x18\x03 \x01(\tR\x06nature\x12%\n" +
+	"\x03ivs\x18\x04 \x01(\v2\x13.pokemon.StatSpreadR\x03ivs\x12%\n" +
+	"\x03evs\x18\x05 \x01(\v2\x13.pokemon.StatSpreadR\x03evs\"\xbd\x01\n" +
+	"\x10StatCalcResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\apokemon\x18\x03 \x01(\tR\apokemon\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06nature\x18\x05 \x01(\tR\x06nature\x12-\n" +
+	"\x05stats\x18\x06 \x03(\v2\x17.pokemon.CalculatedStatR\x05stats\"\x95\x01\n" +
+	"\x0eCalculatedStat\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\x05R\x04base\x12\x0e\n" +
+	"\x02iv\x18\x03 \x01(\x05R\x02iv\x12\x0e\n" +
+	"\x02ev\x18\x04 \x01(\x05R\x02ev\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x05R\x05value\x12%\n" +
+	"\x0enature_percent\x18\x06 \x01(\x05R\rnaturePercent\"\xa9\x01\n" +
+	"\x11IVEstimateRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06nature\x18\x03 \x01(\tR\x06nature\x12)\n" +
+	"\x05stats\x18\x04 \x01(\v2\x13.pokemon.StatSpreadR\x05stats\x12%\n" +
+	"\x03evs\x18\x05 \x01(\v2\x13.pokemon.StatSpreadR\x03evs\"\xb4\x01\n" +
+	"\x12IVEstimateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\apokemon\x18\x03 \x01(\tR\apokemon\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06nature\x18\x05 \x01(\tR\x06nature\x12\"\n" +
+	"\x03ivs\x18\x06 \x03(\v2\x10.pokemon.IVRangeR\x03ivs\"g\n" +
+	"\aIVRange\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06min_iv\x18\x02 \x01(\x05R\x05minIv\x12\x15\n" +
+	"\x06max_iv\x18\x03 \x01(\x05R\x05maxIv\x12\x1a\n" +
+	"\bpossible\x18\x04 \x01(\bR\bpossible\"\xa5\x01\n" +
+	"\rExportRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x17\n" +
+	"\afrom_id\x18\x03 \x01(\x05R\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x04 \x01(\x05R\x04toId\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x05 \x01(\x05R\n" +
+	"generation\x12\x14\n" +
+	"\x05types\x18\x06 \x03(\tR\x05types\"\x86\x01\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x05R\x04rows\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acolumns\x18\x04 \x03(\tR\acolumns\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done2\x8a\t\n" +
+	"\x0ePokemonService\x12?\n" +
+	"\n" +
+	"GetPokemon\x12\x17.pokemon.PokemonRequest\x1a\x18.pokemon.PokemonResponse\x12@\n" +
+	"\rSearchPokemon\x12\x16.pokemon.SearchRequest\x1a\x17.pokemon.SearchResponse\x12C\n" +
+	"\x0eComparePokemon\x12\x17.pokemon.CompareRequest\x1a\x18.pokemon.CompareResponse\x126\n" +
+	"\bPlayQuiz\x12\x14.pokemon.QuizRequest\x1a\x12.pokemon.QuizEvent0\x01\x12>\n" +
+	"\n" +
+	"AnswerQuiz\x12\x13.pokemon.QuizAnswer\x1a\x1b.pokemon.QuizAnswerResponse\x126\n" +
+	"\aGetMove\x12\x14.pokemon.MoveRequest\x1a\x15.pokemon.MoveResponse\x12?\n" +
+	"\n" +
+	"GetMoveset\x12\x17.pokemon.MovesetRequest\x1a\x18.pokemon.MovesetResponse\x12B\n" +
+	"\x0fCalculateDamage\x12\x16.pokemon.DamageRequest\x1a\x17.pokemon.DamageResponse\x12<\n" +
+	"\rStreamPokedex\x12\x17.pokemon.PokedexRequest\x1a\x10.pokemon.Pokemon0\x01\x12B\n" +
+	"\vGetPokemons\x12\x18.pokemon.PokemonsRequest\x1a\x19.pokemon.PokemonsResponse\x12W\n" +
+	"\x10SearchFlavorText\x12 .pokemon.FlavorTextSearchRequest\x1a!.pokemon.FlavorTextSearchResponse\x12F\n" +
+	"\rGetEncounters\x12\x19.pokemon.EncounterRequest\x1a\x1a.pokemon.EncounterResponse\x12B\n" +
+	"\vGetTrending\x12\x18.pokemon.TrendingRequest\x1a\x19.pokemon.TrendingResponse\x12D\n" +
+	"\rCheckBreeding\x12\x18.pokemon.BreedingRequest\x1a\x19.pokemon.BreedingResponse\x12E\n" +
+	"\x0eCalculateStats\x12\x18.pokemon.StatCalcRequest\x1a\x19.pokemon.StatCalcResponse\x12F\n" +
+	"\vEstimateIVs\x12\x1a.pokemon.IVEstimateRequest\x1a\x1b.pokemon.IVEstimateResponse\x12?\n" +
+	"\rExportPokedex\x12\x16.pokemon.ExportRequest\x1a\x14.pokemon.ExportChunk0\x012\xd3\x01\n" +
+	"\vItemService\x126\n" +
+	"\aGetItem\x12\x14.pokemon.ItemRequest\x1a\x15.pokemon.ItemResponse\x12F\n" +
+	"\vSearchItems\x12\x1a.pokemon.ItemSearchRequest\x1a\x1b.pokemon.ItemSearchResponse\x12D\n" +
+	"\tListItems\x12\x1c.pokemon.ItemCategoryRequest\x1a\x19.pokemon.ItemListResponse2T\n" +
+	"\fAdminService\x12D\n" +
+	"\tWarmCache\x12\x19.pokemon.WarmCacheRequest\x1a\x1a.pokemon.WarmCacheProgress0\x01BA\n" +
+	"\x15dev.unifuu.grpc.protoP\x01Z&github.com/unifuu/mewtwo/go/grpc/protob\x06proto3"
+
+var (
+	file_proto_game_proto_rawDescOnce sync.Once
+	file_proto_game_proto_rawDescData []byte
+)
+
+func file_proto_game_proto_rawDescGZIP() []byte {
+	file_proto_game_proto_rawDescOnce.Do(func() {
+		file_proto_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_game_proto_rawDesc), len(file_proto_game_proto_rawDesc)))
+	})
+	return file_proto_game_proto_rawDescData
+}
+
+var file_proto_game_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_proto_game_proto_goTypes = []any{
+	(*PokemonRequest)(nil),           // 0: pokemon.PokemonRequest
+	(*PokemonResponse)(nil),          // 1: pokemon.PokemonResponse
+	(*Pokemon)(nil),                  // 2: pokemon.Pokemon
+	(*Stat)(nil),                     // 3: pokemon.Stat
+	(*SearchRequest)(nil),            // 4: pokemon.SearchRequest
+	(*SearchResponse)(nil),           // 5: pokemon.SearchResponse
+	(*CompareRequest)(nil),           // 6: pokemon.CompareRequest
+	(*CompareResponse)(nil),          // 7: pokemon.CompareResponse
+	(*ComparisonRow)(nil),            // 8: pokemon.ComparisonRow
+	(*Matchup)(nil),                  // 9: pokemon.Matchup
+	(*TypeMultiplier)(nil),           // 10: pokemon.TypeMultiplier
+	(*QuizRequest)(nil),              // 11: pokemon.QuizRequest
+	(*QuizEvent)(nil),                // 12: pokemon.QuizEvent
+	(*QuizRoundStart)(nil),           // 13: pokemon.QuizRoundStart
+	(*QuizHint)(nil),                 // 14: pokemon.QuizHint
+	(*QuizRoundResult)(nil),          // 15: pokemon.QuizRoundResult
+	(*QuizSummary)(nil),              // 16: pokemon.QuizSummary
+	(*QuizPlayerStats)(nil),          // 17: pokemon.QuizPlayerStats
+	(*QuizAnswer)(nil),               // 18: pokemon.QuizAnswer
+	(*QuizAnswerResponse)(nil),       // 19: pokemon.QuizAnswerResponse
+	(*Move)(nil),                     // 20: pokemon.Move
+	(*MoveRequest)(nil),              // 21: pokemon.MoveRequest
+	(*MoveResponse)(nil),             // 22: pokemon.MoveResponse
+	(*MovesetRequest)(nil),           // 23: pokemon.MovesetRequest
+	(*MovesetResponse)(nil),          // 24: pokemon.MovesetResponse
+	(*LearnableMove)(nil),            // 25: pokemon.LearnableMove
+	(*DamageRequest)(nil),            // 26: pokemon.DamageRequest
+	(*DamageResponse)(nil),           // 27: pokemon.DamageResponse
+	(*WarmCacheRequest)(nil),         // 28: pokemon.WarmCacheRequest
+	(*WarmCacheProgress)(nil),        // 29: pokemon.WarmCacheProgress
+	(*PokedexRequest)(nil),           // 30: pokemon.PokedexRequest
+	(*PokemonsRequest)(nil),          // 31: pokemon.PokemonsRequest
+	(*PokemonsResponse)(nil),         // 32: pokemon.PokemonsResponse
+	(*FlavorTextSearchRequest)(nil),  // 33: pokemon.FlavorTextSearchRequest
+	(*FlavorTextSearchResponse)(nil), // 34: pokemon.FlavorTextSearchResponse
+	(*FlavorTextHit)(nil),            // 35: pokemon.FlavorTextHit
+	(*Highlight)(nil),                // 36: pokemon.Highlight
+	(*EncounterRequest)(nil),         // 37: pokemon.EncounterRequest
+	(*EncounterResponse)(nil),        // 38: pokemon.EncounterResponse
+	(*VersionEncounters)(nil),        // 39: pokemon.VersionEncounters
+	(*Encounter)(nil),                // 40: pokemon.Encounter
+	(*Item)(nil),                     // 41: pokemon.Item
+	(*ItemHolder)(nil),               // 42: pokemon.ItemHolder
+	(*ItemRarity)(nil),               // 43: pokemon.ItemRarity
+	(*Berry)(nil),                    // 44: pokemon.Berry
+	(*BerryFlavor)(nil),              // 45: pokemon.BerryFlavor
+	(*ItemSummary)(nil),              // 46: pokemon.ItemSummary
+	(*ItemRequest)(nil),              // 47: pokemon.ItemRequest
+	(*ItemResponse)(nil),             // 48: pokemon.ItemResponse
+	(*ItemSearchRequest)(nil),        // 49: pokemon.ItemSearchRequest
+	(*ItemSearchResponse)(nil),       // 50: pokemon.ItemSearchResponse
+	(*ItemCategoryRequest)(nil),      // 51: pokemon.ItemCategoryRequest
+	(*ItemListResponse)(nil),         // 52: pokemon.ItemListResponse
+	(*TrendingRequest)(nil),          // 53: pokemon.TrendingRequest
+	(*TrendingResponse)(nil),         // 54: pokemon.TrendingResponse
+	(*TrendingPokemon)(nil),          // 55: pokemon.TrendingPokemon
+	(*BreedingRequest)(nil),          // 56: pokemon.BreedingRequest
+	(*BreedingResponse)(nil),         // 57: pokemon.BreedingResponse
+	(*BreedingParent)(nil),           // 58: pokemon.BreedingParent
+	(*BreedingOffspring)(nil),        // 59: pokemon.BreedingOffspring
+	(*StatSpread)(nil),               // 60: pokemon.StatSpread
+	(*StatCalcRequest)(nil),          // 61: pokemon.StatCalcRequest
+	(*StatCalcResponse)(nil),         // 62: pokemon.StatCalcResponse
+	(*CalculatedStat)(nil),           // 63: pokemon.CalculatedStat
+	(*IVEstimateRequest)(nil),        // 64: pokemon.IVEstimateRequest
+	(*IVEstimateResponse)(nil),       // 65: pokemon.IVEstimateResponse
+	(*IVRange)(nil),                  // 66: pokemon.IVRange
+	(*ExportRequest)(nil),            // 67: pokemon.ExportRequest
+	(*ExportChunk)(nil),              // 68: pokemon.ExportChunk
+}
+var file_proto_game_proto_depIdxs = []int32{
+	2,  // 0: pokemon.PokemonResponse.pokemon:type_name -> pokemon.Pokemon
+	3,  // 1: pokemon.Pokemon.stats:type_name -> pokemon.Stat
+	2,  // 2: pokemon.SearchResponse.results:type_name -> pokemon.Pokemon
+	2,  // 3: pokemon.CompareResponse.pokemon:type_name -> pokemon.Pokemon
+	8,  // 4: pokemon.CompareResponse.stats:type_name -> pokemon.ComparisonRow
+	8,  // 5: pokemon.CompareResponse.measurements:type_name -> pokemon.ComparisonRow
+	9,  // 6: pokemon.CompareResponse.matchups:type_name -> pokemon.Matchup
+	10, // 7: pokemon.Matchup.multipliers:type_name -> pokemon.TypeMultiplier
+	13, // 8: pokemon.QuizEvent.round_start:type_name -> pokemon.QuizRoundStart
+	14, // 9: pokemon.QuizEvent.hint:type_name -> pokemon.QuizHint
+	15, // 10: pokemon.QuizEvent.round_result:type_name -> pokemon.QuizRoundResult
+	16, // 11: pokemon.QuizEvent.summary:type_name -> pokemon.QuizSummary
+	2,  // 12: pokemon.QuizRoundResult.answer:type_name -> pokemon.Pokemon
+	17, // 13: pokemon.QuizSummary.player:type_name -> pokemon.QuizPlayerStats
+	20, // 14: pokemon.MoveResponse.move:type_name -> pokemon.Move
+	25, // 15: pokemon.MovesetResponse.moves:type_name -> pokemon.LearnableMove
+	20, // 16: pokemon.DamageResponse.move:type_name -> pokemon.Move
+	1,  // 17: pokemon.PokemonsResponse.results:type_name -> pokemon.PokemonResponse
+	35, // 18: pokemon.FlavorTextSearchResponse.hits:type_name -> pokemon.FlavorTextHit
+	36, // 19: pokemon.FlavorTextHit.highlights:type_name -> pokemon.Highlight
+	39, // 20: pokemon.EncounterResponse.versions:type_name -> pokemon.VersionEncounters
+	40, // 21: pokemon.VersionEncounters.encounters:type_name -> pokemon.Encounter
+	42, // 22: pokemon.Item.held_by:type_name -> pokemon.ItemHolder
+	44, // 23: pokemon.Item.berry:type_name -> pokemon.Berry
+	43, // 24: pokemon.ItemHolder.versions:type_name -> pokemon.ItemRarity
+	45, // 25: pokemon.Berry.flavors:type_name -> pokemon.BerryFlavor
+	41, // 26: pokemon.ItemResponse.item:type_name -> pokemon.Item
+	46, // 27: pokemon.ItemSearchResponse.items:type_name -> pokemon.ItemSummary
+	46, // 28: pokemon.ItemListResponse.items:type_name -> pokemon.ItemSummary
+	55, // 29: pokemon.TrendingResponse.pokemon:type_name -> pokemon.TrendingPokemon
+	58, // 30: pokemon.BreedingResponse.parents:type_name -> pokemon.BreedingParent
+	59, // 31: pokemon.BreedingResponse.offspring:type_name -> pokemon.BreedingOffspring
+	60, // 32: pokemon.StatCalcRequest.ivs:type_name -> pokemon.StatSpread
+	60, // 33: pokemon.StatCalcRequest.evs:type_name -> pokemon.StatSpread
+	63, // 34: pokemon.StatCalcResponse.stats:type_name -> pokemon.CalculatedStat
+	60, // 35: pokemon.IVEstimateRequest.stats:type_name -> pokemon.StatSpread
+	60, // 36: pokemon.IVEstimateRequest.evs:type_name -> pokemon.StatSpread
+	66, // 37: pokemon.IVEstimateResponse.ivs:type_name -> pokemon.IVRange
+	0,  // 38: pokemon.PokemonService.GetPokemon:input_type -> pokemon.PokemonRequest
+	4,  // 39: pokemon.PokemonService.SearchPokemon:input_type -> pokemon.SearchRequest
+	6,  // 40: pokemon.PokemonService.ComparePokemon:input_type -> pokemon.CompareRequest
+	11, // 41: pokemon.PokemonService.PlayQuiz:input_type -> pokemon.QuizRequest
+	18, // 42: pokemon.PokemonService.AnswerQuiz:input_type -> pokemon.QuizAnswer
+	21, // 43: pokemon.PokemonService.GetMove:input_type -> pokemon.MoveRequest
+	23, // 44: pokemon.PokemonService.GetMoveset:input_type -> pokemon.MovesetRequest
+	26, // 45: pokemon.PokemonService.CalculateDamage:input_type -> pokemon.DamageRequest
+	30, // 46: pokemon.PokemonService.StreamPokedex:input_type -> pokemon.PokedexRequest
+	31, // 47: pokemon.PokemonService.GetPokemons:input_type -> pokemon.PokemonsRequest
+	33, // 48: pokemon.PokemonService.SearchFlavorText:input_type -> pokemon.FlavorTextSearchRequest
+	37, // 49: pokemon.PokemonService.GetEncounters:input_type -> pokemon.EncounterRequest
+	53, // 50: pokemon.PokemonService.GetTrending:input_type -> pokemon.TrendingRequest
+	56, // 51: pokemon.PokemonService.CheckBreeding:input_type -> pokemon.BreedingRequest
+	61, // 52: pokemon.PokemonService.CalculateStats:input_type -> pokemon.StatCalcRequest
+	64, // 53: pokemon.PokemonService.EstimateIVs:input_type -> pokemon.IVEstimateRequest
+	67, // 54: pokemon.PokemonService.ExportPokedex:input_type -> pokemon.ExportRequest
+	47, // 55: pokemon.ItemService.GetItem:input_type -> pokemon.ItemRequest
+	49, // 56: pokemon.ItemService.SearchItems:input_type -> pokemon.ItemSearchRequest
+	51, // 57: pokemon.ItemService.ListItems:input_type -> pokemon.ItemCategoryRequest
+	28, // 58: pokemon.AdminService.WarmCache:input_type -> pokemon.WarmCacheRequest
+	1,  // 59: pokemon.PokemonService.GetPokemon:output_type -> pokemon.PokemonResponse
+	5,  // 60: pokemon.PokemonService.SearchPokemon:output_type -> pokemon.SearchResponse
+	7,  // 61: pokemon.PokemonService.ComparePokemon:output_type -> pokemon.CompareResponse
+	12, // 62: pokemon.PokemonService.PlayQuiz:output_type -> pokemon.QuizEvent
+	19, // 63: pokemon.PokemonService.AnswerQuiz:output_type -> pokemon.QuizAnswerResponse
+	22, // 64: pokemon.PokemonService.GetMove:output_type -> pokemon.MoveResponse
+	24, // 65: pokemon.PokemonService.GetMoveset:output_type -> pokemon.MovesetResponse
+	27, // 66: pokemon.PokemonService.CalculateDamage:output_type -> pokemon.DamageResponse
+	2,  // 67: pokemon.PokemonService.StreamPokedex:output_type -> pokemon.Pokemon
+	32, // 68: pokemon.PokemonService.GetPokemons:output_type -> pokemon.PokemonsResponse
+	34, // 69: pokemon.PokemonService.SearchFlavorText:output_type -> pokemon.FlavorTextSearchResponse
+	38, // 70: pokemon.PokemonService.GetEncounters:output_type -> pokemon.EncounterResponse
+	54, // 71: pokemon.PokemonService.GetTrending:output_type -> pokemon.TrendingResponse
+	57, // 72: pokemon.PokemonService.CheckBreeding:output_type -> pokemon.BreedingResponse
+	62, // 73: pokemon.PokemonService.CalculateStats:output_type -> pokemon.StatCalcResponse
+	65, // 74: pokemon.PokemonService.EstimateIVs:output_type -> pokemon.IVEstimateResponse
+	68, // 75: pokemon.PokemonService.ExportPokedex:output_type -> pokemon.ExportChunk
+	48, // 76: pokemon.ItemService.GetItem:output_type -> pokemon.ItemResponse
+	50, // 77: pokemon.ItemService.SearchItems:output_type -> pokemon.ItemSearchResponse
+	52, // 78: pokemon.ItemService.ListItems:output_type -> pokemon.ItemListResponse
+	29, // 79: pokemon.AdminService.WarmCache:output_type -> pokemon.WarmCacheProgress
+	59, // [59:80] is the sub-list for method output_type
+	38, // [38:59] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_proto_game_proto_init() }
+func file_proto_game_proto_init() {
+	if File_proto_game_proto != nil {
+		return
+	}
+	file_proto_game_proto_msgTypes[12].OneofWrappers = []any{
+		(*QuizEvent_RoundStart)(nil),
+		(*QuizEvent_Hint)(nil),
+		(*QuizEvent_RoundResult)(nil),
+		(*QuizEvent_Summary)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -380,9 +5509,9 @@ func file_proto_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_game_proto_rawDesc), len(file_proto_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   69,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_proto_game_proto_goTypes,
 		DependencyIndexes: file_proto_game_proto_depIdxs,
@@ -19,8 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PokemonService_GetPokemon_FullMethodName    = "/pokemon.PokemonService/GetPokemon"
-	PokemonService_SearchPokemon_FullMethodName = "/pokemon.PokemonService/SearchPokemon"
+	PokemonService_GetPokemon_FullMethodName       = "/pokemon.PokemonService/GetPokemon"
+	PokemonService_SearchPokemon_FullMethodName    = "/pokemon.PokemonService/SearchPokemon"
+	PokemonService_ComparePokemon_FullMethodName   = "/pokemon.PokemonService/ComparePokemon"
+	PokemonService_PlayQuiz_FullMethodName         = "/pokemon.PokemonService/PlayQuiz"
+	PokemonService_AnswerQuiz_FullMethodName       = "/pokemon.PokemonService/AnswerQuiz"
+	PokemonService_GetMove_FullMethodName          = "/pokemon.PokemonService/GetMove"
+	PokemonService_GetMoveset_FullMethodName       = "/pokemon.PokemonService/GetMoveset"
+	PokemonService_CalculateDamage_FullMethodName  = "/pokemon.PokemonService/CalculateDamage"
+	PokemonService_StreamPokedex_FullMethodName    = "/pokemon.PokemonService/StreamPokedex"
+	PokemonService_GetPokemons_FullMethodName      = "/pokemon.PokemonService/GetPokemons"
+	PokemonService_SearchFlavorText_FullMethodName = "/pokemon.PokemonService/SearchFlavorText"
+	PokemonService_GetEncounters_FullMethodName    = "/pokemon.PokemonService/GetEncounters"
+	PokemonService_GetTrending_FullMethodName      = "/pokemon.PokemonService/GetTrending"
+	PokemonService_CheckBreeding_FullMethodName    = "/pokemon.PokemonService/CheckBreeding"
+	PokemonService_CalculateStats_FullMethodName   = "/pokemon.PokemonService/CalculateStats"
+	PokemonService_EstimateIVs_FullMethodName      = "/pokemon.PokemonService/EstimateIVs"
+	PokemonService_ExportPokedex_FullMethodName    = "/pokemon.PokemonService/ExportPokedex"
 )
 
 // PokemonServiceClient is the client API for PokemonService service.
@@ -33,6 +48,36 @@ type PokemonServiceClient interface {
 	GetPokemon(ctx context.Context, in *PokemonRequest, opts ...grpc.CallOption) (*PokemonResponse, error)
 	// Search multiple Pokemon (optional, for future expansion)
 	SearchPokemon(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Compare two or more Pokemon side by side
+	ComparePokemon(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+	// Play "Who's That Pokemon?": streams rounds and hints until the quiz ends
+	PlayQuiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuizEvent], error)
+	// Submit a guess for the current round of a running quiz
+	AnswerQuiz(ctx context.Context, in *QuizAnswer, opts ...grpc.CallOption) (*QuizAnswerResponse, error)
+	// Get a move by ID or name
+	GetMove(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
+	// List the moves a Pokemon can learn
+	GetMoveset(ctx context.Context, in *MovesetRequest, opts ...grpc.CallOption) (*MovesetResponse, error)
+	// Calculate the damage range of a move between two Pokemon
+	CalculateDamage(ctx context.Context, in *DamageRequest, opts ...grpc.CallOption) (*DamageResponse, error)
+	// Stream Pokemon in Pokedex order over an ID range
+	StreamPokedex(ctx context.Context, in *PokedexRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pokemon], error)
+	// Get many Pokemon at once, each result succeeds or fails on its own
+	GetPokemons(ctx context.Context, in *PokemonsRequest, opts ...grpc.CallOption) (*PokemonsResponse, error)
+	// Find Pokemon whose Pokedex entries match a description
+	SearchFlavorText(ctx context.Context, in *FlavorTextSearchRequest, opts ...grpc.CallOption) (*FlavorTextSearchResponse, error)
+	// Get where a Pokemon can be caught, grouped by game version
+	GetEncounters(ctx context.Context, in *EncounterRequest, opts ...grpc.CallOption) (*EncounterResponse, error)
+	// Get the most looked up Pokemon over the last hour, day or week
+	GetTrending(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error)
+	// Check whether two Pokemon can breed and what the egg hatches into
+	CheckBreeding(ctx context.Context, in *BreedingRequest, opts ...grpc.CallOption) (*BreedingResponse, error)
+	// Calculate a Pokemon's actual stats from its level, nature, IVs and EVs
+	CalculateStats(ctx context.Context, in *StatCalcRequest, opts ...grpc.CallOption) (*StatCalcResponse, error)
+	// Work out which IVs give a Pokemon's observed stats
+	EstimateIVs(ctx context.Context, in *IVEstimateRequest, opts ...grpc.CallOption) (*IVEstimateResponse, error)
+	// Export the Pokedex as CSV, JSON Lines or Parquet, streamed in chunks
+	ExportPokedex(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type pokemonServiceClient struct {
@@ -63,6 +108,183 @@ func (c *pokemonServiceClient) SearchPokemon(ctx context.Context, in *SearchRequ
 	return out, nil
 }
 
+func (c *pokemonServiceClient) ComparePokemon(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, PokemonService_ComparePokemon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) PlayQuiz(ctx context.Context, in *QuizRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QuizEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokemonService_ServiceDesc.Streams[0], PokemonService_PlayQuiz_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QuizRequest, QuizEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_PlayQuizClient = grpc.ServerStreamingClient[QuizEvent]
+
+func (c *pokemonServiceClient) AnswerQuiz(ctx context.Context, in *QuizAnswer, opts ...grpc.CallOption) (*QuizAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuizAnswerResponse)
+	err := c.cc.Invoke(ctx, PokemonService_AnswerQuiz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) GetMove(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, PokemonService_GetMove_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) GetMoveset(ctx context.Context, in *MovesetRequest, opts ...grpc.CallOption) (*MovesetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovesetResponse)
+	err := c.cc.Invoke(ctx, PokemonService_GetMoveset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) CalculateDamage(ctx context.Context, in *DamageRequest, opts ...grpc.CallOption) (*DamageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DamageResponse)
+	err := c.cc.Invoke(ctx, PokemonService_CalculateDamage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) StreamPokedex(ctx context.Context, in *PokedexRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pokemon], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokemonService_ServiceDesc.Streams[1], PokemonService_StreamPokedex_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PokedexRequest, Pokemon]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_StreamPokedexClient = grpc.ServerStreamingClient[Pokemon]
+
+func (c *pokemonServiceClient) GetPokemons(ctx context.Context, in *PokemonsRequest, opts ...grpc.CallOption) (*PokemonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PokemonsResponse)
+	err := c.cc.Invoke(ctx, PokemonService_GetPokemons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) SearchFlavorText(ctx context.Context, in *FlavorTextSearchRequest, opts ...grpc.CallOption) (*FlavorTextSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlavorTextSearchResponse)
+	err := c.cc.Invoke(ctx, PokemonService_SearchFlavorText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) GetEncounters(ctx context.Context, in *EncounterRequest, opts ...grpc.CallOption) (*EncounterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncounterResponse)
+	err := c.cc.Invoke(ctx, PokemonService_GetEncounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) GetTrending(ctx context.Context, in *TrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingResponse)
+	err := c.cc.Invoke(ctx, PokemonService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) CheckBreeding(ctx context.Context, in *BreedingRequest, opts ...grpc.CallOption) (*BreedingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BreedingResponse)
+	err := c.cc.Invoke(ctx, PokemonService_CheckBreeding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) CalculateStats(ctx context.Context, in *StatCalcRequest, opts ...grpc.CallOption) (*StatCalcResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatCalcResponse)
+	err := c.cc.Invoke(ctx, PokemonService_CalculateStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) EstimateIVs(ctx context.Context, in *IVEstimateRequest, opts ...grpc.CallOption) (*IVEstimateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IVEstimateResponse)
+	err := c.cc.Invoke(ctx, PokemonService_EstimateIVs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokemonServiceClient) ExportPokedex(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PokemonService_ServiceDesc.Streams[2], PokemonService_ExportPokedex_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_ExportPokedexClient = grpc.ServerStreamingClient[ExportChunk]
+
 // PokemonServiceServer is the server API for PokemonService service.
 // All implementations must embed UnimplementedPokemonServiceServer
 // for forward compatibility.
@@ -73,6 +295,36 @@ type PokemonServiceServer interface {
 	GetPokemon(context.Context, *PokemonRequest) (*PokemonResponse, error)
 	// Search multiple Pokemon (optional, for future expansion)
 	SearchPokemon(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Compare two or more Pokemon side by side
+	ComparePokemon(context.Context, *CompareRequest) (*CompareResponse, error)
+	// Play "Who's That Pokemon?": streams rounds and hints until the quiz ends
+	PlayQuiz(*QuizRequest, grpc.ServerStreamingServer[QuizEvent]) error
+	// Submit a guess for the current round of a running quiz
+	AnswerQuiz(context.Context, *QuizAnswer) (*QuizAnswerResponse, error)
+	// Get a move by ID or name
+	GetMove(context.Context, *MoveRequest) (*MoveResponse, error)
+	// List the moves a Pokemon can learn
+	GetMoveset(context.Context, *MovesetRequest) (*MovesetResponse, error)
+	// Calculate the damage range of a move between two Pokemon
+	CalculateDamage(context.Context, *DamageRequest) (*DamageResponse, error)
+	// Stream Pokemon in Pokedex order over an ID range
+	StreamPokedex(*PokedexRequest, grpc.ServerStreamingServer[Pokemon]) error
+	// Get many Pokemon at once, each result succeeds or fails on its own
+	GetPokemons(context.Context, *PokemonsRequest) (*PokemonsResponse, error)
+	// Find Pokemon whose Pokedex entries match a description
+	SearchFlavorText(context.Context, *FlavorTextSearchRequest) (*FlavorTextSearchResponse, error)
+	// Get where a Pokemon can be caught, grouped by game version
+	GetEncounters(context.Context, *EncounterRequest) (*EncounterResponse, error)
+	// Get the most looked up Pokemon over the last hour, day or week
+	GetTrending(context.Context, *TrendingRequest) (*TrendingResponse, error)
+	// Check whether two Pokemon can breed and what the egg hatches into
+	CheckBreeding(context.Context, *BreedingRequest) (*BreedingResponse, error)
+	// Calculate a Pokemon's actual stats from its level, nature, IVs and EVs
+	CalculateStats(context.Context, *StatCalcRequest) (*StatCalcResponse, error)
+	// Work out which IVs give a Pokemon's observed stats
+	EstimateIVs(context.Context, *IVEstimateRequest) (*IVEstimateResponse, error)
+	// Export the Pokedex as CSV, JSON Lines or Parquet, streamed in chunks
+	ExportPokedex(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedPokemonServiceServer()
 }
 
@@ -89,6 +341,51 @@ func (UnimplementedPokemonServiceServer) GetPokemon(context.Context, *PokemonReq
 func (UnimplementedPokemonServiceServer) SearchPokemon(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPokemon not implemented")
 }
+func (UnimplementedPokemonServiceServer) ComparePokemon(context.Context, *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComparePokemon not implemented")
+}
+func (UnimplementedPokemonServiceServer) PlayQuiz(*QuizRequest, grpc.ServerStreamingServer[QuizEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method PlayQuiz not implemented")
+}
+func (UnimplementedPokemonServiceServer) AnswerQuiz(context.Context, *QuizAnswer) (*QuizAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerQuiz not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetMove(context.Context, *MoveRequest) (*MoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMove not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetMoveset(context.Context, *MovesetRequest) (*MovesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoveset not implemented")
+}
+func (UnimplementedPokemonServiceServer) CalculateDamage(context.Context, *DamageRequest) (*DamageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateDamage not implemented")
+}
+func (UnimplementedPokemonServiceServer) StreamPokedex(*PokedexRequest, grpc.ServerStreamingServer[Pokemon]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPokedex not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetPokemons(context.Context, *PokemonsRequest) (*PokemonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPokemons not implemented")
+}
+func (UnimplementedPokemonServiceServer) SearchFlavorText(context.Context, *FlavorTextSearchRequest) (*FlavorTextSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFlavorText not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetEncounters(context.Context, *EncounterRequest) (*EncounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncounters not implemented")
+}
+func (UnimplementedPokemonServiceServer) GetTrending(context.Context, *TrendingRequest) (*TrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedPokemonServiceServer) CheckBreeding(context.Context, *BreedingRequest) (*BreedingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBreeding not implemented")
+}
+func (UnimplementedPokemonServiceServer) CalculateStats(context.Context, *StatCalcRequest) (*StatCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateStats not implemented")
+}
+func (UnimplementedPokemonServiceServer) EstimateIVs(context.Context, *IVEstimateRequest) (*IVEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateIVs not implemented")
+}
+func (UnimplementedPokemonServiceServer) ExportPokedex(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPokedex not implemented")
+}
 func (UnimplementedPokemonServiceServer) mustEmbedUnimplementedPokemonServiceServer() {}
 func (UnimplementedPokemonServiceServer) testEmbeddedByValue()                        {}
 
@@ -146,6 +443,255 @@ func _PokemonService_SearchPokemon_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokemonService_ComparePokemon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).ComparePokemon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_ComparePokemon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).ComparePokemon(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_PlayQuiz_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuizRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokemonServiceServer).PlayQuiz(m, &grpc.GenericServerStream[QuizRequest, QuizEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_PlayQuizServer = grpc.ServerStreamingServer[QuizEvent]
+
+func _PokemonService_AnswerQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuizAnswer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).AnswerQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_AnswerQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).AnswerQuiz(ctx, req.(*QuizAnswer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_GetMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetMove(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_GetMoveset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetMoveset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetMoveset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetMoveset(ctx, req.(*MovesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_CalculateDamage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DamageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).CalculateDamage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_CalculateDamage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).CalculateDamage(ctx, req.(*DamageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_StreamPokedex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PokedexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokemonServiceServer).StreamPokedex(m, &grpc.GenericServerStream[PokedexRequest, Pokemon]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_StreamPokedexServer = grpc.ServerStreamingServer[Pokemon]
+
+func _PokemonService_GetPokemons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PokemonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetPokemons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetPokemons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetPokemons(ctx, req.(*PokemonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_SearchFlavorText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlavorTextSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).SearchFlavorText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_SearchFlavorText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).SearchFlavorText(ctx, req.(*FlavorTextSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_GetEncounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetEncounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetEncounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetEncounters(ctx, req.(*EncounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).GetTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_GetTrending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).GetTrending(ctx, req.(*TrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_CheckBreeding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreedingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).CheckBreeding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_CheckBreeding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).CheckBreeding(ctx, req.(*BreedingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_CalculateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).CalculateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_CalculateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).CalculateStats(ctx, req.(*StatCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_EstimateIVs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IVEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokemonServiceServer).EstimateIVs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokemonService_EstimateIVs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokemonServiceServer).EstimateIVs(ctx, req.(*IVEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokemonService_ExportPokedex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokemonServiceServer).ExportPokedex(m, &grpc.GenericServerStream[ExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PokemonService_ExportPokedexServer = grpc.ServerStreamingServer[ExportChunk]
+
 // PokemonService_ServiceDesc is the grpc.ServiceDesc for PokemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,7 +707,372 @@ var PokemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchPokemon",
 			Handler:    _PokemonService_SearchPokemon_Handler,
 		},
+		{
+			MethodName: "ComparePokemon",
+			Handler:    _PokemonService_ComparePokemon_Handler,
+		},
+		{
+			MethodName: "AnswerQuiz",
+			Handler:    _PokemonService_AnswerQuiz_Handler,
+		},
+		{
+			MethodName: "GetMove",
+			Handler:    _PokemonService_GetMove_Handler,
+		},
+		{
+			MethodName: "GetMoveset",
+			Handler:    _PokemonService_GetMoveset_Handler,
+		},
+		{
+			MethodName: "CalculateDamage",
+			Handler:    _PokemonService_CalculateDamage_Handler,
+		},
+		{
+			MethodName: "GetPokemons",
+			Handler:    _PokemonService_GetPokemons_Handler,
+		},
+		{
+			MethodName: "SearchFlavorText",
+			Handler:    _PokemonService_SearchFlavorText_Handler,
+		},
+		{
+			MethodName: "GetEncounters",
+			Handler:    _PokemonService_GetEncounters_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _PokemonService_GetTrending_Handler,
+		},
+		{
+			MethodName: "CheckBreeding",
+			Handler:    _PokemonService_CheckBreeding_Handler,
+		},
+		{
+			MethodName: "CalculateStats",
+			Handler:    _PokemonService_CalculateStats_Handler,
+		},
+		{
+			MethodName: "EstimateIVs",
+			Handler:    _PokemonService_EstimateIVs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PlayQuiz",
+			Handler:       _PokemonService_PlayQuiz_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPokedex",
+			Handler:       _PokemonService_StreamPokedex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportPokedex",
+			Handler:       _PokemonService_ExportPokedex_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/game.proto",
+}
+
+const (
+	ItemService_GetItem_FullMethodName     = "/pokemon.ItemService/GetItem"
+	ItemService_SearchItems_FullMethodName = "/pokemon.ItemService/SearchItems"
+	ItemService_ListItems_FullMethodName   = "/pokemon.ItemService/ListItems"
+)
+
+// ItemServiceClient is the client API for ItemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Item dex: items and berries
+type ItemServiceClient interface {
+	// Get an item or berry by ID or name
+	GetItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*ItemResponse, error)
+	// Find items whose name contains the query
+	SearchItems(ctx context.Context, in *ItemSearchRequest, opts ...grpc.CallOption) (*ItemSearchResponse, error)
+	// List the items in a category (e.g. "standard-balls") or pocket (e.g. "berries")
+	ListItems(ctx context.Context, in *ItemCategoryRequest, opts ...grpc.CallOption) (*ItemListResponse, error)
+}
+
+type itemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewItemServiceClient(cc grpc.ClientConnInterface) ItemServiceClient {
+	return &itemServiceClient{cc}
+}
+
+func (c *itemServiceClient) GetItem(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*ItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemResponse)
+	err := c.cc.Invoke(ctx, ItemService_GetItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) SearchItems(ctx context.Context, in *ItemSearchRequest, opts ...grpc.CallOption) (*ItemSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemSearchResponse)
+	err := c.cc.Invoke(ctx, ItemService_SearchItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ListItems(ctx context.Context, in *ItemCategoryRequest, opts ...grpc.CallOption) (*ItemListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemListResponse)
+	err := c.cc.Invoke(ctx, ItemService_ListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ItemServiceServer is the server API for ItemService service.
+// All implementations must embed UnimplementedItemServiceServer
+// for forward compatibility.
+//
+// Item dex: items and berries
+type ItemServiceServer interface {
+	// Get an item or berry by ID or name
+	GetItem(context.Context, *ItemRequest) (*ItemResponse, error)
+	// Find items whose name contains the query
+	SearchItems(context.Context, *ItemSearchRequest) (*ItemSearchResponse, error)
+	// List the items in a category (e.g. "standard-balls") or pocket (e.g. "berries")
+	ListItems(context.Context, *ItemCategoryRequest) (*ItemListResponse, error)
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+// UnimplementedItemServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedItemServiceServer struct{}
+
+func (UnimplementedItemServiceServer) GetItem(context.Context, *ItemRequest) (*ItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedItemServiceServer) SearchItems(context.Context, *ItemSearchRequest) (*ItemSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
+}
+func (UnimplementedItemServiceServer) ListItems(context.Context, *ItemCategoryRequest) (*ItemListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
+func (UnimplementedItemServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeItemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ItemServiceServer will
+// result in compilation errors.
+type UnsafeItemServiceServer interface {
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+func RegisterItemServiceServer(s grpc.ServiceRegistrar, srv ItemServiceServer) {
+	// If the following call pancis, it indicates UnimplementedItemServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ItemService_ServiceDesc, srv)
+}
+
+func _ItemService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetItem(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_SearchItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).SearchItems(ctx, req.(*ItemSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).ListItems(ctx, req.(*ItemCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ItemService_ServiceDesc is the grpc.ServiceDesc for ItemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ItemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pokemon.ItemService",
+	HandlerType: (*ItemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetItem",
+			Handler:    _ItemService_GetItem_Handler,
+		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _ItemService_SearchItems_Handler,
+		},
+		{
+			MethodName: "ListItems",
+			Handler:    _ItemService_ListItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/game.proto",
 }
+
+const (
+	AdminService_WarmCache_FullMethodName = "/pokemon.AdminService/WarmCache"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Operational RPCs, only served on the native gRPC port and guarded by
+// an admin token sent as "authorization: Bearer <token>" metadata
+type AdminServiceClient interface {
+	// Prefetch Pokemon into the server cache, streaming progress until done
+	WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WarmCacheProgress], error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WarmCacheProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_WarmCache_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WarmCacheRequest, WarmCacheProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_WarmCacheClient = grpc.ServerStreamingClient[WarmCacheProgress]
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Operational RPCs, only served on the native gRPC port and guarded by
+// an admin token sent as "authorization: Bearer <token>" metadata
+type AdminServiceServer interface {
+	// Prefetch Pokemon into the server cache, streaming progress until done
+	WarmCache(*WarmCacheRequest, grpc.ServerStreamingServer[WarmCacheProgress]) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) WarmCache(*WarmCacheRequest, grpc.ServerStreamingServer[WarmCacheProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method WarmCache not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_WarmCache_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WarmCacheRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).WarmCache(m, &grpc.GenericServerStream[WarmCacheRequest, WarmCacheProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_WarmCacheServer = grpc.ServerStreamingServer[WarmCacheProgress]
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pokemon.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WarmCache",
+			Handler:       _AdminService_WarmCache_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/game.proto",
+}
@@ -0,0 +1,885 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/game.proto
+
+package protoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	proto "grpc/proto"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PokemonServiceName is the fully-qualified name of the PokemonService service.
+	PokemonServiceName = "pokemon.PokemonService"
+	// ItemServiceName is the fully-qualified name of the ItemService service.
+	ItemServiceName = "pokemon.ItemService"
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "pokemon.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PokemonServiceGetPokemonProcedure is the fully-qualified name of the PokemonService's GetPokemon
+	// RPC.
+	PokemonServiceGetPokemonProcedure = "/pokemon.PokemonService/GetPokemon"
+	// PokemonServiceSearchPokemonProcedure is the fully-qualified name of the PokemonService's
+	// SearchPokemon RPC.
+	PokemonServiceSearchPokemonProcedure = "/pokemon.PokemonService/SearchPokemon"
+	// PokemonServiceComparePokemonProcedure is the fully-qualified name of the PokemonService's
+	// ComparePokemon RPC.
+	PokemonServiceComparePokemonProcedure = "/pokemon.PokemonService/ComparePokemon"
+	// PokemonServicePlayQuizProcedure is the fully-qualified name of the PokemonService's PlayQuiz RPC.
+	PokemonServicePlayQuizProcedure = "/pokemon.PokemonService/PlayQuiz"
+	// PokemonServiceAnswerQuizProcedure is the fully-qualified name of the PokemonService's AnswerQuiz
+	// RPC.
+	PokemonServiceAnswerQuizProcedure = "/pokemon.PokemonService/AnswerQuiz"
+	// PokemonServiceGetMoveProcedure is the fully-qualified name of the PokemonService's GetMove RPC.
+	PokemonServiceGetMoveProcedure = "/pokemon.PokemonService/GetMove"
+	// PokemonServiceGetMovesetProcedure is the fully-qualified name of the PokemonService's GetMoveset
+	// RPC.
+	PokemonServiceGetMovesetProcedure = "/pokemon.PokemonService/GetMoveset"
+	// PokemonServiceCalculateDamageProcedure is the fully-qualified name of the PokemonService's
+	// CalculateDamage RPC.
+	PokemonServiceCalculateDamageProcedure = "/pokemon.PokemonService/CalculateDamage"
+	// PokemonServiceStreamPokedexProcedure is the fully-qualified name of the PokemonService's
+	// StreamPokedex RPC.
+	PokemonServiceStreamPokedexProcedure = "/pokemon.PokemonService/StreamPokedex"
+	// PokemonServiceGetPokemonsProcedure is the fully-qualified name of the PokemonService's
+	// GetPokemons RPC.
+	PokemonServiceGetPokemonsProcedure = "/pokemon.PokemonService/GetPokemons"
+	// PokemonServiceSearchFlavorTextProcedure is the fully-qualified name of the PokemonService's
+	// SearchFlavorText RPC.
+	PokemonServiceSearchFlavorTextProcedure = "/pokemon.PokemonService/SearchFlavorText"
+	// PokemonServiceGetEncountersProcedure is the fully-qualified name of the PokemonService's
+	// GetEncounters RPC.
+	PokemonServiceGetEncountersProcedure = "/pokemon.PokemonService/GetEncounters"
+	// PokemonServiceGetTrendingProcedure is the fully-qualified name of the PokemonService's
+	// GetTrending RPC.
+	PokemonServiceGetTrendingProcedure = "/pokemon.PokemonService/GetTrending"
+	// PokemonServiceCheckBreedingProcedure is the fully-qualified name of the PokemonService's
+	// CheckBreeding RPC.
+	PokemonServiceCheckBreedingProcedure = "/pokemon.PokemonService/CheckBreeding"
+	// PokemonServiceCalculateStatsProcedure is the fully-qualified name of the PokemonService's
+	// CalculateStats RPC.
+	PokemonServiceCalculateStatsProcedure = "/pokemon.PokemonService/CalculateStats"
+	// PokemonServiceEstimateIVsProcedure is the fully-qualified name of the PokemonService's
+	// EstimateIVs RPC.
+	PokemonServiceEstimateIVsProcedure = "/pokemon.PokemonService/EstimateIVs"
+	// PokemonServiceExportPokedexProcedure is the fully-qualified name of the PokemonService's
+	// ExportPokedex RPC.
+	PokemonServiceExportPokedexProcedure = "/pokemon.PokemonService/ExportPokedex"
+	// ItemServiceGetItemProcedure is the fully-qualified name of the ItemService's GetItem RPC.
+	ItemServiceGetItemProcedure = "/pokemon.ItemService/GetItem"
+	// ItemServiceSearchItemsProcedure is the fully-qualified name of the ItemService's SearchItems RPC.
+	ItemServiceSearchItemsProcedure = "/pokemon.ItemService/SearchItems"
+	// ItemServiceListItemsProcedure is the fully-qualified name of the ItemService's ListItems RPC.
+	ItemServiceListItemsProcedure = "/pokemon.ItemService/ListItems"
+	// AdminServiceWarmCacheProcedure is the fully-qualified name of the AdminService's WarmCache RPC.
+	AdminServiceWarmCacheProcedure = "/pokemon.AdminService/WarmCache"
+)
+
+// PokemonServiceClient is a client for the pokemon.PokemonService service.
+type PokemonServiceClient interface {
+	// Get Pokemon by ID or name
+	GetPokemon(context.Context, *proto.PokemonRequest) (*proto.PokemonResponse, error)
+	// Search multiple Pokemon (optional, for future expansion)
+	SearchPokemon(context.Context, *proto.SearchRequest) (*proto.SearchResponse, error)
+	// Compare two or more Pokemon side by side
+	ComparePokemon(context.Context, *proto.CompareRequest) (*proto.CompareResponse, error)
+	// Play "Who's That Pokemon?": streams rounds and hints until the quiz ends
+	PlayQuiz(context.Context, *proto.QuizRequest) (*connect.ServerStreamForClient[proto.QuizEvent], error)
+	// Submit a guess for the current round of a running quiz
+	AnswerQuiz(context.Context, *proto.QuizAnswer) (*proto.QuizAnswerResponse, error)
+	// Get a move by ID or name
+	GetMove(context.Context, *proto.MoveRequest) (*proto.MoveResponse, error)
+	// List the moves a Pokemon can learn
+	GetMoveset(context.Context, *proto.MovesetRequest) (*proto.MovesetResponse, error)
+	// Calculate the damage range of a move between two Pokemon
+	CalculateDamage(context.Context, *proto.DamageRequest) (*proto.DamageResponse, error)
+	// Stream Pokemon in Pokedex order over an ID range
+	StreamPokedex(context.Context, *proto.PokedexRequest) (*connect.ServerStreamForClient[proto.Pokemon], error)
+	// Get many Pokemon at once, each result succeeds or fails on its own
+	GetPokemons(context.Context, *proto.PokemonsRequest) (*proto.PokemonsResponse, error)
+	// Find Pokemon whose Pokedex entries match a description
+	SearchFlavorText(context.Context, *proto.FlavorTextSearchRequest) (*proto.FlavorTextSearchResponse, error)
+	// Get where a Pokemon can be caught, grouped by game version
+	GetEncounters(context.Context, *proto.EncounterRequest) (*proto.EncounterResponse, error)
+	// Get the most looked up Pokemon over the last hour, day or week
+	GetTrending(context.Context, *proto.TrendingRequest) (*proto.TrendingResponse, error)
+	// Check whether two Pokemon can breed and what the egg hatches into
+	CheckBreeding(context.Context, *proto.BreedingRequest) (*proto.BreedingResponse, error)
+	// Calculate a Pokemon's actual stats from its level, nature, IVs and EVs
+	CalculateStats(context.Context, *proto.StatCalcRequest) (*proto.StatCalcResponse, error)
+	// Work out which IVs give a Pokemon's observed stats
+	EstimateIVs(context.Context, *proto.IVEstimateRequest) (*proto.IVEstimateResponse, error)
+	// Export the Pokedex as CSV, JSON Lines or Parquet, streamed in chunks
+	ExportPokedex(context.Context, *proto.ExportRequest) (*connect.ServerStreamForClient[proto.ExportChunk], error)
+}
+
+// NewPokemonServiceClient constructs a client for the pokemon.PokemonService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPokemonServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PokemonServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	pokemonServiceMethods := proto.File_proto_game_proto.Services().ByName("PokemonService").Methods()
+	return &pokemonServiceClient{
+		getPokemon: connect.NewClient[proto.PokemonRequest, proto.PokemonResponse](
+			httpClient,
+			baseURL+PokemonServiceGetPokemonProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetPokemon")),
+			connect.WithClientOptions(opts...),
+		),
+		searchPokemon: connect.NewClient[proto.SearchRequest, proto.SearchResponse](
+			httpClient,
+			baseURL+PokemonServiceSearchPokemonProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("SearchPokemon")),
+			connect.WithClientOptions(opts...),
+		),
+		comparePokemon: connect.NewClient[proto.CompareRequest, proto.CompareResponse](
+			httpClient,
+			baseURL+PokemonServiceComparePokemonProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("ComparePokemon")),
+			connect.WithClientOptions(opts...),
+		),
+		playQuiz: connect.NewClient[proto.QuizRequest, proto.QuizEvent](
+			httpClient,
+			baseURL+PokemonServicePlayQuizProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("PlayQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		answerQuiz: connect.NewClient[proto.QuizAnswer, proto.QuizAnswerResponse](
+			httpClient,
+			baseURL+PokemonServiceAnswerQuizProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("AnswerQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		getMove: connect.NewClient[proto.MoveRequest, proto.MoveResponse](
+			httpClient,
+			baseURL+PokemonServiceGetMoveProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetMove")),
+			connect.WithClientOptions(opts...),
+		),
+		getMoveset: connect.NewClient[proto.MovesetRequest, proto.MovesetResponse](
+			httpClient,
+			baseURL+PokemonServiceGetMovesetProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetMoveset")),
+			connect.WithClientOptions(opts...),
+		),
+		calculateDamage: connect.NewClient[proto.DamageRequest, proto.DamageResponse](
+			httpClient,
+			baseURL+PokemonServiceCalculateDamageProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("CalculateDamage")),
+			connect.WithClientOptions(opts...),
+		),
+		streamPokedex: connect.NewClient[proto.PokedexRequest, proto.Pokemon](
+			httpClient,
+			baseURL+PokemonServiceStreamPokedexProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("StreamPokedex")),
+			connect.WithClientOptions(opts...),
+		),
+		getPokemons: connect.NewClient[proto.PokemonsRequest, proto.PokemonsResponse](
+			httpClient,
+			baseURL+PokemonServiceGetPokemonsProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetPokemons")),
+			connect.WithClientOptions(opts...),
+		),
+		searchFlavorText: connect.NewClient[proto.FlavorTextSearchRequest, proto.FlavorTextSearchResponse](
+			httpClient,
+			baseURL+PokemonServiceSearchFlavorTextProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("SearchFlavorText")),
+			connect.WithClientOptions(opts...),
+		),
+		getEncounters: connect.NewClient[proto.EncounterRequest, proto.EncounterResponse](
+			httpClient,
+			baseURL+PokemonServiceGetEncountersProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetEncounters")),
+			connect.WithClientOptions(opts...),
+		),
+		getTrending: connect.NewClient[proto.TrendingRequest, proto.TrendingResponse](
+			httpClient,
+			baseURL+PokemonServiceGetTrendingProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("GetTrending")),
+			connect.WithClientOptions(opts...),
+		),
+		checkBreeding: connect.NewClient[proto.BreedingRequest, proto.BreedingResponse](
+			httpClient,
+			baseURL+PokemonServiceCheckBreedingProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("CheckBreeding")),
+			connect.WithClientOptions(opts...),
+		),
+		calculateStats: connect.NewClient[proto.StatCalcRequest, proto.StatCalcResponse](
+			httpClient,
+			baseURL+PokemonServiceCalculateStatsProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("CalculateStats")),
+			connect.WithClientOptions(opts...),
+		),
+		estimateIVs: connect.NewClient[proto.IVEstimateRequest, proto.IVEstimateResponse](
+			httpClient,
+			baseURL+PokemonServiceEstimateIVsProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("EstimateIVs")),
+			connect.WithClientOptions(opts...),
+		),
+		exportPokedex: connect.NewClient[proto.ExportRequest, proto.ExportChunk](
+			httpClient,
+			baseURL+PokemonServiceExportPokedexProcedure,
+			connect.WithSchema(pokemonServiceMethods.ByName("ExportPokedex")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pokemonServiceClient implements PokemonServiceClient.
+type pokemonServiceClient struct {
+	getPokemon       *connect.Client[proto.PokemonRequest, proto.PokemonResponse]
+	searchPokemon    *connect.Client[proto.SearchRequest, proto.SearchResponse]
+	comparePokemon   *connect.Client[proto.CompareRequest, proto.CompareResponse]
+	playQuiz         *connect.Client[proto.QuizRequest, proto.QuizEvent]
+	answerQuiz       *connect.Client[proto.QuizAnswer, proto.QuizAnswerResponse]
+	getMove          *connect.Client[proto.MoveRequest, proto.MoveResponse]
+	getMoveset       *connect.Client[proto.MovesetRequest, proto.MovesetResponse]
+	calculateDamage  *connect.Client[proto.DamageRequest, proto.DamageResponse]
+	streamPokedex    *connect.Client[proto.PokedexRequest, proto.Pokemon]
+	getPokemons      *connect.Client[proto.PokemonsRequest, proto.PokemonsResponse]
+	searchFlavorText *connect.Client[proto.FlavorTextSearchRequest, proto.FlavorTextSearchResponse]
+	getEncounters    *connect.Client[proto.EncounterRequest, proto.EncounterResponse]
+	getTrending      *connect.Client[proto.TrendingRequest, proto.TrendingResponse]
+	checkBreeding    *connect.Client[proto.BreedingRequest, proto.BreedingResponse]
+	calculateStats   *connect.Client[proto.StatCalcRequest, proto.StatCalcResponse]
+	estimateIVs      *connect.Client[proto.IVEstimateRequest, proto.IVEstimateResponse]
+	exportPokedex    *connect.Client[proto.ExportRequest, proto.ExportChunk]
+}
+
+// GetPokemon calls pokemon.PokemonService.GetPokemon.
+func (c *pokemonServiceClient) GetPokemon(ctx context.Context, req *proto.PokemonRequest) (*proto.PokemonResponse, error) {
+	response, err := c.getPokemon.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchPokemon calls pokemon.PokemonService.SearchPokemon.
+func (c *pokemonServiceClient) SearchPokemon(ctx context.Context, req *proto.SearchRequest) (*proto.SearchResponse, error) {
+	response, err := c.searchPokemon.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ComparePokemon calls pokemon.PokemonService.ComparePokemon.
+func (c *pokemonServiceClient) ComparePokemon(ctx context.Context, req *proto.CompareRequest) (*proto.CompareResponse, error) {
+	response, err := c.comparePokemon.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PlayQuiz calls pokemon.PokemonService.PlayQuiz.
+func (c *pokemonServiceClient) PlayQuiz(ctx context.Context, req *proto.QuizRequest) (*connect.ServerStreamForClient[proto.QuizEvent], error) {
+	return c.playQuiz.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// AnswerQuiz calls pokemon.PokemonService.AnswerQuiz.
+func (c *pokemonServiceClient) AnswerQuiz(ctx context.Context, req *proto.QuizAnswer) (*proto.QuizAnswerResponse, error) {
+	response, err := c.answerQuiz.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMove calls pokemon.PokemonService.GetMove.
+func (c *pokemonServiceClient) GetMove(ctx context.Context, req *proto.MoveRequest) (*proto.MoveResponse, error) {
+	response, err := c.getMove.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMoveset calls pokemon.PokemonService.GetMoveset.
+func (c *pokemonServiceClient) GetMoveset(ctx context.Context, req *proto.MovesetRequest) (*proto.MovesetResponse, error) {
+	response, err := c.getMoveset.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CalculateDamage calls pokemon.PokemonService.CalculateDamage.
+func (c *pokemonServiceClient) CalculateDamage(ctx context.Context, req *proto.DamageRequest) (*proto.DamageResponse, error) {
+	response, err := c.calculateDamage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StreamPokedex calls pokemon.PokemonService.StreamPokedex.
+func (c *pokemonServiceClient) StreamPokedex(ctx context.Context, req *proto.PokedexRequest) (*connect.ServerStreamForClient[proto.Pokemon], error) {
+	return c.streamPokedex.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetPokemons calls pokemon.PokemonService.GetPokemons.
+func (c *pokemonServiceClient) GetPokemons(ctx context.Context, req *proto.PokemonsRequest) (*proto.PokemonsResponse, error) {
+	response, err := c.getPokemons.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchFlavorText calls pokemon.PokemonService.SearchFlavorText.
+func (c *pokemonServiceClient) SearchFlavorText(ctx context.Context, req *proto.FlavorTextSearchRequest) (*proto.FlavorTextSearchResponse, error) {
+	response, err := c.searchFlavorText.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetEncounters calls pokemon.PokemonService.GetEncounters.
+func (c *pokemonServiceClient) GetEncounters(ctx context.Context, req *proto.EncounterRequest) (*proto.EncounterResponse, error) {
+	response, err := c.getEncounters.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTrending calls pokemon.PokemonService.GetTrending.
+func (c *pokemonServiceClient) GetTrending(ctx context.Context, req *proto.TrendingRequest) (*proto.TrendingResponse, error) {
+	response, err := c.getTrending.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckBreeding calls pokemon.PokemonService.CheckBreeding.
+func (c *pokemonServiceClient) CheckBreeding(ctx context.Context, req *proto.BreedingRequest) (*proto.BreedingResponse, error) {
+	response, err := c.checkBreeding.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CalculateStats calls pokemon.PokemonService.CalculateStats.
+func (c *pokemonServiceClient) CalculateStats(ctx context.Context, req *proto.StatCalcRequest) (*proto.StatCalcResponse, error) {
+	response, err := c.calculateStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EstimateIVs calls pokemon.PokemonService.EstimateIVs.
+func (c *pokemonServiceClient) EstimateIVs(ctx context.Context, req *proto.IVEstimateRequest) (*proto.IVEstimateResponse, error) {
+	response, err := c.estimateIVs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportPokedex calls pokemon.PokemonService.ExportPokedex.
+func (c *pokemonServiceClient) ExportPokedex(ctx context.Context, req *proto.ExportRequest) (*connect.ServerStreamForClient[proto.ExportChunk], error) {
+	return c.exportPokedex.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// PokemonServiceHandler is an implementation of the pokemon.PokemonService service.
+type PokemonServiceHandler interface {
+	// Get Pokemon by ID or name
+	GetPokemon(context.Context, *proto.PokemonRequest) (*proto.PokemonResponse, error)
+	// Search multiple Pokemon (optional, for future expansion)
+	SearchPokemon(context.Context, *proto.SearchRequest) (*proto.SearchResponse, error)
+	// Compare two or more Pokemon side by side
+	ComparePokemon(context.Context, *proto.CompareRequest) (*proto.CompareResponse, error)
+	// Play "Who's That Pokemon?": streams rounds and hints until the quiz ends
+	PlayQuiz(context.Context, *proto.QuizRequest, *connect.ServerStream[proto.QuizEvent]) error
+	// Submit a guess for the current round of a running quiz
+	AnswerQuiz(context.Context, *proto.QuizAnswer) (*proto.QuizAnswerResponse, error)
+	// Get a move by ID or name
+	GetMove(context.Context, *proto.MoveRequest) (*proto.MoveResponse, error)
+	// List the moves a Pokemon can learn
+	GetMoveset(context.Context, *proto.MovesetRequest) (*proto.MovesetResponse, error)
+	// Calculate the damage range of a move between two Pokemon
+	CalculateDamage(context.Context, *proto.DamageRequest) (*proto.DamageResponse, error)
+	// Stream Pokemon in Pokedex order over an ID range
+	StreamPokedex(context.Context, *proto.PokedexRequest, *connect.ServerStream[proto.Pokemon]) error
+	// Get many Pokemon at once, each result succeeds or fails on its own
+	GetPokemons(context.Context, *proto.PokemonsRequest) (*proto.PokemonsResponse, error)
+	// Find Pokemon whose Pokedex entries match a description
+	SearchFlavorText(context.Context, *proto.FlavorTextSearchRequest) (*proto.FlavorTextSearchResponse, error)
+	// Get where a Pokemon can be caught, grouped by game version
+	GetEncounters(context.Context, *proto.EncounterRequest) (*proto.EncounterResponse, error)
+	// Get the most looked up Pokemon over the last hour, day or week
+	GetTrending(context.Context, *proto.TrendingRequest) (*proto.TrendingResponse, error)
+	// Check whether two Pokemon can breed and what the egg hatches into
+	CheckBreeding(context.Context, *proto.BreedingRequest) (*proto.BreedingResponse, error)
+	// Calculate a Pokemon's actual stats from its level, nature, IVs and EVs
+	CalculateStats(context.Context, *proto.StatCalcRequest) (*proto.StatCalcResponse, error)
+	// Work out which IVs give a Pokemon's observed stats
+	EstimateIVs(context.Context, *proto.IVEstimateRequest) (*proto.IVEstimateResponse, error)
+	// Export the Pokedex as CSV, JSON Lines or Parquet, streamed in chunks
+	ExportPokedex(context.Context, *proto.ExportRequest, *connect.ServerStream[proto.ExportChunk]) error
+}
+
+// NewPokemonServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPokemonServiceHandler(svc PokemonServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pokemonServiceMethods := proto.File_proto_game_proto.Services().ByName("PokemonService").Methods()
+	pokemonServiceGetPokemonHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetPokemonProcedure,
+		svc.GetPokemon,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetPokemon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceSearchPokemonHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceSearchPokemonProcedure,
+		svc.SearchPokemon,
+		connect.WithSchema(pokemonServiceMethods.ByName("SearchPokemon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceComparePokemonHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceComparePokemonProcedure,
+		svc.ComparePokemon,
+		connect.WithSchema(pokemonServiceMethods.ByName("ComparePokemon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServicePlayQuizHandler := connect.NewServerStreamHandlerSimple(
+		PokemonServicePlayQuizProcedure,
+		svc.PlayQuiz,
+		connect.WithSchema(pokemonServiceMethods.ByName("PlayQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceAnswerQuizHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceAnswerQuizProcedure,
+		svc.AnswerQuiz,
+		connect.WithSchema(pokemonServiceMethods.ByName("AnswerQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceGetMoveHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetMoveProcedure,
+		svc.GetMove,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetMove")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceGetMovesetHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetMovesetProcedure,
+		svc.GetMoveset,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetMoveset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceCalculateDamageHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceCalculateDamageProcedure,
+		svc.CalculateDamage,
+		connect.WithSchema(pokemonServiceMethods.ByName("CalculateDamage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceStreamPokedexHandler := connect.NewServerStreamHandlerSimple(
+		PokemonServiceStreamPokedexProcedure,
+		svc.StreamPokedex,
+		connect.WithSchema(pokemonServiceMethods.ByName("StreamPokedex")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceGetPokemonsHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetPokemonsProcedure,
+		svc.GetPokemons,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetPokemons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceSearchFlavorTextHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceSearchFlavorTextProcedure,
+		svc.SearchFlavorText,
+		connect.WithSchema(pokemonServiceMethods.ByName("SearchFlavorText")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceGetEncountersHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetEncountersProcedure,
+		svc.GetEncounters,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetEncounters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceGetTrendingHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceGetTrendingProcedure,
+		svc.GetTrending,
+		connect.WithSchema(pokemonServiceMethods.ByName("GetTrending")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceCheckBreedingHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceCheckBreedingProcedure,
+		svc.CheckBreeding,
+		connect.WithSchema(pokemonServiceMethods.ByName("CheckBreeding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceCalculateStatsHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceCalculateStatsProcedure,
+		svc.CalculateStats,
+		connect.WithSchema(pokemonServiceMethods.ByName("CalculateStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceEstimateIVsHandler := connect.NewUnaryHandlerSimple(
+		PokemonServiceEstimateIVsProcedure,
+		svc.EstimateIVs,
+		connect.WithSchema(pokemonServiceMethods.ByName("EstimateIVs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pokemonServiceExportPokedexHandler := connect.NewServerStreamHandlerSimple(
+		PokemonServiceExportPokedexProcedure,
+		svc.ExportPokedex,
+		connect.WithSchema(pokemonServiceMethods.ByName("ExportPokedex")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pokemon.PokemonService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PokemonServiceGetPokemonProcedure:
+			pokemonServiceGetPokemonHandler.ServeHTTP(w, r)
+		case PokemonServiceSearchPokemonProcedure:
+			pokemonServiceSearchPokemonHandler.ServeHTTP(w, r)
+		case PokemonServiceComparePokemonProcedure:
+			pokemonServiceComparePokemonHandler.ServeHTTP(w, r)
+		case PokemonServicePlayQuizProcedure:
+			pokemonServicePlayQuizHandler.ServeHTTP(w, r)
+		case PokemonServiceAnswerQuizProcedure:
+			pokemonServiceAnswerQuizHandler.ServeHTTP(w, r)
+		case PokemonServiceGetMoveProcedure:
+			pokemonServiceGetMoveHandler.ServeHTTP(w, r)
+		case PokemonServiceGetMovesetProcedure:
+			pokemonServiceGetMovesetHandler.ServeHTTP(w, r)
+		case PokemonServiceCalculateDamageProcedure:
+			pokemonServiceCalculateDamageHandler.ServeHTTP(w, r)
+		case PokemonServiceStreamPokedexProcedure:
+			pokemonServiceStreamPokedexHandler.ServeHTTP(w, r)
+		case PokemonServiceGetPokemonsProcedure:
+			pokemonServiceGetPokemonsHandler.ServeHTTP(w, r)
+		case PokemonServiceSearchFlavorTextProcedure:
+			pokemonServiceSearchFlavorTextHandler.ServeHTTP(w, r)
+		case PokemonServiceGetEncountersProcedure:
+			pokemonServiceGetEncountersHandler.ServeHTTP(w, r)
+		case PokemonServiceGetTrendingProcedure:
+			pokemonServiceGetTrendingHandler.ServeHTTP(w, r)
+		case PokemonServiceCheckBreedingProcedure:
+			pokemonServiceCheckBreedingHandler.ServeHTTP(w, r)
+		case PokemonServiceCalculateStatsProcedure:
+			pokemonServiceCalculateStatsHandler.ServeHTTP(w, r)
+		case PokemonServiceEstimateIVsProcedure:
+			pokemonServiceEstimateIVsHandler.ServeHTTP(w, r)
+		case PokemonServiceExportPokedexProcedure:
+			pokemonServiceExportPokedexHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPokemonServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPokemonServiceHandler struct{}
+
+func (UnimplementedPokemonServiceHandler) GetPokemon(context.Context, *proto.PokemonRequest) (*proto.PokemonResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetPokemon is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) SearchPokemon(context.Context, *proto.SearchRequest) (*proto.SearchResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.SearchPokemon is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) ComparePokemon(context.Context, *proto.CompareRequest) (*proto.CompareResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.ComparePokemon is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) PlayQuiz(context.Context, *proto.QuizRequest, *connect.ServerStream[proto.QuizEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.PlayQuiz is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) AnswerQuiz(context.Context, *proto.QuizAnswer) (*proto.QuizAnswerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.AnswerQuiz is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) GetMove(context.Context, *proto.MoveRequest) (*proto.MoveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetMove is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) GetMoveset(context.Context, *proto.MovesetRequest) (*proto.MovesetResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetMoveset is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) CalculateDamage(context.Context, *proto.DamageRequest) (*proto.DamageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.CalculateDamage is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) StreamPokedex(context.Context, *proto.PokedexRequest, *connect.ServerStream[proto.Pokemon]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.StreamPokedex is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) GetPokemons(context.Context, *proto.PokemonsRequest) (*proto.PokemonsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetPokemons is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) SearchFlavorText(context.Context, *proto.FlavorTextSearchRequest) (*proto.FlavorTextSearchResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.SearchFlavorText is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) GetEncounters(context.Context, *proto.EncounterRequest) (*proto.EncounterResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetEncounters is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) GetTrending(context.Context, *proto.TrendingRequest) (*proto.TrendingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.GetTrending is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) CheckBreeding(context.Context, *proto.BreedingRequest) (*proto.BreedingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.CheckBreeding is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) CalculateStats(context.Context, *proto.StatCalcRequest) (*proto.StatCalcResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.CalculateStats is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) EstimateIVs(context.Context, *proto.IVEstimateRequest) (*proto.IVEstimateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.EstimateIVs is not implemented"))
+}
+
+func (UnimplementedPokemonServiceHandler) ExportPokedex(context.Context, *proto.ExportRequest, *connect.ServerStream[proto.ExportChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.PokemonService.ExportPokedex is not implemented"))
+}
+
+// ItemServiceClient is a client for the pokemon.ItemService service.
+type ItemServiceClient interface {
+	// Get an item or berry by ID or name
+	GetItem(context.Context, *proto.ItemRequest) (*proto.ItemResponse, error)
+	// Find items whose name contains the query
+	SearchItems(context.Context, *proto.ItemSearchRequest) (*proto.ItemSearchResponse, error)
+	// List the items in a category (e.g. "standard-balls") or pocket (e.g. "berries")
+	ListItems(context.Context, *proto.ItemCategoryRequest) (*proto.ItemListResponse, error)
+}
+
+// NewItemServiceClient constructs a client for the pokemon.ItemService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewItemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ItemServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	itemServiceMethods := proto.File_proto_game_proto.Services().ByName("ItemService").Methods()
+	return &itemServiceClient{
+		getItem: connect.NewClient[proto.ItemRequest, proto.ItemResponse](
+			httpClient,
+			baseURL+ItemServiceGetItemProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("GetItem")),
+			connect.WithClientOptions(opts...),
+		),
+		searchItems: connect.NewClient[proto.ItemSearchRequest, proto.ItemSearchResponse](
+			httpClient,
+			baseURL+ItemServiceSearchItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("SearchItems")),
+			connect.WithClientOptions(opts...),
+		),
+		listItems: connect.NewClient[proto.ItemCategoryRequest, proto.ItemListResponse](
+			httpClient,
+			baseURL+ItemServiceListItemsProcedure,
+			connect.WithSchema(itemServiceMethods.ByName("ListItems")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// itemServiceClient implements ItemServiceClient.
+type itemServiceClient struct {
+	getItem     *connect.Client[proto.ItemRequest, proto.ItemResponse]
+	searchItems *connect.Client[proto.ItemSearchRequest, proto.ItemSearchResponse]
+	listItems   *connect.Client[proto.ItemCategoryRequest, proto.ItemListResponse]
+}
+
+// GetItem calls pokemon.ItemService.GetItem.
+func (c *itemServiceClient) GetItem(ctx context.Context, req *proto.ItemRequest) (*proto.ItemResponse, error) {
+	response, err := c.getItem.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchItems calls pokemon.ItemService.SearchItems.
+func (c *itemServiceClient) SearchItems(ctx context.Context, req *proto.ItemSearchRequest) (*proto.ItemSearchResponse, error) {
+	response, err := c.searchItems.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListItems calls pokemon.ItemService.ListItems.
+func (c *itemServiceClient) ListItems(ctx context.Context, req *proto.ItemCategoryRequest) (*proto.ItemListResponse, error) {
+	response, err := c.listItems.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ItemServiceHandler is an implementation of the pokemon.ItemService service.
+type ItemServiceHandler interface {
+	// Get an item or berry by ID or name
+	GetItem(context.Context, *proto.ItemRequest) (*proto.ItemResponse, error)
+	// Find items whose name contains the query
+	SearchItems(context.Context, *proto.ItemSearchRequest) (*proto.ItemSearchResponse, error)
+	// List the items in a category (e.g. "standard-balls") or pocket (e.g. "berries")
+	ListItems(context.Context, *proto.ItemCategoryRequest) (*proto.ItemListResponse, error)
+}
+
+// NewItemServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewItemServiceHandler(svc ItemServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	itemServiceMethods := proto.File_proto_game_proto.Services().ByName("ItemService").Methods()
+	itemServiceGetItemHandler := connect.NewUnaryHandlerSimple(
+		ItemServiceGetItemProcedure,
+		svc.GetItem,
+		connect.WithSchema(itemServiceMethods.ByName("GetItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceSearchItemsHandler := connect.NewUnaryHandlerSimple(
+		ItemServiceSearchItemsProcedure,
+		svc.SearchItems,
+		connect.WithSchema(itemServiceMethods.ByName("SearchItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	itemServiceListItemsHandler := connect.NewUnaryHandlerSimple(
+		ItemServiceListItemsProcedure,
+		svc.ListItems,
+		connect.WithSchema(itemServiceMethods.ByName("ListItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pokemon.ItemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ItemServiceGetItemProcedure:
+			itemServiceGetItemHandler.ServeHTTP(w, r)
+		case ItemServiceSearchItemsProcedure:
+			itemServiceSearchItemsHandler.ServeHTTP(w, r)
+		case ItemServiceListItemsProcedure:
+			itemServiceListItemsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedItemServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedItemServiceHandler struct{}
+
+func (UnimplementedItemServiceHandler) GetItem(context.Context, *proto.ItemRequest) (*proto.ItemResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.ItemService.GetItem is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) SearchItems(context.Context, *proto.ItemSearchRequest) (*proto.ItemSearchResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.ItemService.SearchItems is not implemented"))
+}
+
+func (UnimplementedItemServiceHandler) ListItems(context.Context, *proto.ItemCategoryRequest) (*proto.ItemListResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.ItemService.ListItems is not implemented"))
+}
+
+// AdminServiceClient is a client for the pokemon.AdminService service.
+type AdminServiceClient interface {
+	// Prefetch Pokemon into the server cache, streaming progress until done
+	WarmCache(context.Context, *proto.WarmCacheRequest) (*connect.ServerStreamForClient[proto.WarmCacheProgress], error)
+}
+
+// NewAdminServiceClient constructs a client for the pokemon.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := proto.File_proto_game_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		warmCache: connect.NewClient[proto.WarmCacheRequest, proto.WarmCacheProgress](
+			httpClient,
+			baseURL+AdminServiceWarmCacheProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("WarmCache")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	warmCache *connect.Client[proto.WarmCacheRequest, proto.WarmCacheProgress]
+}
+
+// WarmCache calls pokemon.AdminService.WarmCache.
+func (c *adminServiceClient) WarmCache(ctx context.Context, req *proto.WarmCacheRequest) (*connect.ServerStreamForClient[proto.WarmCacheProgress], error) {
+	return c.warmCache.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// AdminServiceHandler is an implementation of the pokemon.AdminService service.
+type AdminServiceHandler interface {
+	// Prefetch Pokemon into the server cache, streaming progress until done
+	WarmCache(context.Context, *proto.WarmCacheRequest, *connect.ServerStream[proto.WarmCacheProgress]) error
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := proto.File_proto_game_proto.Services().ByName("AdminService").Methods()
+	adminServiceWarmCacheHandler := connect.NewServerStreamHandlerSimple(
+		AdminServiceWarmCacheProcedure,
+		svc.WarmCache,
+		connect.WithSchema(adminServiceMethods.ByName("WarmCache")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pokemon.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceWarmCacheProcedure:
+			adminServiceWarmCacheHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) WarmCache(context.Context, *proto.WarmCacheRequest, *connect.ServerStream[proto.WarmCacheProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pokemon.AdminService.WarmCache is not implemented"))
+}
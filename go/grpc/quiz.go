@@ -0,0 +1,456 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	mathrand "math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultQuizRounds       = 10
+	maxQuizRounds           = 50
+	defaultQuizHintInterval = 10 * time.Second
+	maxQuizPoints           = 100
+	minQuizPoints           = 25
+	quizHintPenalty         = 25
+	quizPlayerRetention     = 30 * 24 * time.Hour // Totals of players idle this long are forgotten
+)
+
+// Hints are revealed in this order, one per hint interval.
+var quizHintKinds = []string{"type", "first_letter", "generation"}
+
+// quizManager tracks running quiz sessions and per player totals. Players
+// who haven't finished a quiz within quizPlayerRetention are dropped.
+type quizManager struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*quizSession
+	players  map[string]*quizPlayer
+}
+
+type quizPlayer struct {
+	stats      *pb.QuizPlayerStats
+	lastPlayed time.Time
+}
+
+func newQuizManager() *quizManager {
+	return &quizManager{
+		now:      time.Now,
+		sessions: make(map[string]*quizSession),
+		players:  make(map[string]*quizPlayer),
+	}
+}
+
+func (m *quizManager) start(session *quizSession) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[session.id] = session
+}
+
+func (m *quizManager) get(id string) *quizSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sessions[id]
+}
+
+func (m *quizManager) remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, id)
+}
+
+// record folds a finished session into the player's totals.
+func (m *quizManager) record(session *quizSession) *pb.QuizPlayerStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.prune(now)
+
+	player, ok := m.players[session.playerID]
+	if !ok {
+		player = &quizPlayer{stats: &pb.QuizPlayerStats{}}
+		m.players[session.playerID] = player
+	}
+	player.lastPlayed = now
+	stats := player.stats
+
+	summary := session.summary()
+	stats.GamesPlayed++
+	stats.TotalScore += summary.Score
+	stats.BestStreak = max(stats.BestStreak, summary.BestStreak)
+
+	return &pb.QuizPlayerStats{
+		GamesPlayed: stats.GamesPlayed,
+		TotalScore:  stats.TotalScore,
+		BestStreak:  stats.BestStreak,
+	}
+}
+
+// prune drops players idle for longer than quizPlayerRetention. The caller
+// must hold m.mu.
+func (m *quizManager) prune(now time.Time) {
+	cutoff := now.Add(-quizPlayerRetention)
+	for id, player := range m.players {
+		if player.lastPlayed.Before(cutoff) {
+			delete(m.players, id)
+		}
+	}
+}
+
+// quizSession is one player's run through a quiz.
+type quizSession struct {
+	id       string
+	playerID string
+	rounds   int
+	minID    int
+	maxID    int
+	rng      *mathrand.Rand
+	used     map[int]bool
+
+	mu         sync.Mutex
+	answer     *pb.Pokemon
+	species    string // Also accepted when the answer is a form, e.g. "deoxys" for Deoxys-Normal
+	startedAt  time.Time
+	hintsShown int
+	done       chan struct{}
+	solved     bool
+	points     int32
+	elapsed    time.Duration
+	score      int32
+	streak     int32
+	bestStreak int32
+	solvedN    int32
+	roundTimes []int64
+}
+
+func newQuizSession(id, playerID string, rounds, minGen, maxGen int, seed uint64) *quizSession {
+	minID, maxID := generationRange(minGen, maxGen)
+	return &quizSession{
+		id:       id,
+		playerID: playerID,
+		rounds:   min(rounds, maxID-minID+1),
+		minID:    minID,
+		maxID:    maxID,
+		rng:      mathrand.New(mathrand.NewPCG(seed, seed)),
+		used:     make(map[int]bool),
+	}
+}
+
+// nextID picks a random Pokedex ID that hasn't been used in this session.
+func (q *quizSession) nextID() int {
+	for {
+		id := q.minID + q.rng.IntN(q.maxID-q.minID+1)
+		if !q.used[id] {
+			q.used[id] = true
+			return id
+		}
+	}
+}
+
+func (q *quizSession) startRound(answer *pb.Pokemon, species string, now time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.answer = answer
+	q.species = species
+	q.startedAt = now
+	q.hintsShown = 0
+	q.done = make(chan struct{})
+	q.solved = false
+	q.points = 0
+	q.elapsed = 0
+}
+
+// nextHint reveals the next hint, or returns nil once all have been shown.
+func (q *quizSession) nextHint() *pb.QuizHint {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.hintsShown >= len(quizHintKinds) {
+		return nil
+	}
+
+	kind := quizHintKinds[q.hintsShown]
+	q.hintsShown++
+
+	hint := &pb.QuizHint{Kind: kind}
+	switch kind {
+	case "type":
+		hint.Text = strings.Join(q.answer.Types, "/") + " type"
+	case "first_letter":
+		hint.Text = "Starts with " + q.answer.Name[:1]
+	case "generation":
+		hint.Text = generationName(generationOf(int(q.answer.Id)))
+	}
+	return hint
+}
+
+// guess checks an answer for the current round and ends it when correct.
+func (q *quizSession) guess(guess string, now time.Time) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.answer == nil || q.isDone() {
+		return false, fmt.Errorf("no round in progress")
+	}
+
+	if !sameName(guess, q.answer.Name) && !sameName(guess, q.species) {
+		return false, nil
+	}
+
+	q.solved = true
+	q.points = max(maxQuizPoints-quizHintPenalty*int32(q.hintsShown), minQuizPoints)
+	q.score += q.points
+	q.streak++
+	q.bestStreak = max(q.bestStreak, q.streak)
+	q.solvedN++
+	q.endRound(now)
+	return true, nil
+}
+
+// timeout ends the current round unsolved, unless it already ended.
+func (q *quizSession) timeout(now time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.isDone() {
+		return
+	}
+	q.streak = 0
+	q.endRound(now)
+}
+
+func (q *quizSession) endRound(now time.Time) {
+	q.elapsed = now.Sub(q.startedAt)
+	q.roundTimes = append(q.roundTimes, q.elapsed.Milliseconds())
+	close(q.done)
+}
+
+func (q *quizSession) isDone() bool {
+	select {
+	case <-q.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *quizSession) roundDone() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.done
+}
+
+func (q *quizSession) result() *pb.QuizRoundResult {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return &pb.QuizRoundResult{
+		Answer:    q.answer,
+		Solved:    q.solved,
+		Points:    q.points,
+		ElapsedMs: q.elapsed.Milliseconds(),
+		Score:     q.score,
+		Streak:    q.streak,
+	}
+}
+
+func (q *quizSession) summary() *pb.QuizSummary {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return &pb.QuizSummary{
+		PlayerId:     q.playerID,
+		Score:        q.score,
+		SolvedRounds: q.solvedN,
+		TotalRounds:  int32(q.rounds),
+		BestStreak:   q.bestStreak,
+		RoundTimesMs: append([]int64(nil), q.roundTimes...),
+	}
+}
+
+// sameName compares names ignoring case, spaces and punctuation.
+func sameName(a, b string) bool {
+	strip := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, s)
+	}
+	return strip(a) != "" && strip(a) == strip(b)
+}
+
+func newSessionID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *pokemonServer) PlayQuiz(req *pb.QuizRequest, stream pb.PokemonService_PlayQuizServer) error {
+	ctx := stream.Context()
+
+	rounds := int(req.Rounds)
+	if rounds == 0 {
+		rounds = defaultQuizRounds
+	}
+	if rounds < 0 || rounds > maxQuizRounds {
+		return status.Errorf(codes.InvalidArgument, "rounds must be between 1 and %d", maxQuizRounds)
+	}
+
+	minGen, maxGen := int(req.MinGeneration), int(req.MaxGeneration)
+	if minGen == 0 {
+		minGen = 1
+	}
+	if maxGen == 0 {
+		maxGen = len(generationEnds)
+	}
+	if minGen < 1 || maxGen > len(generationEnds) || minGen > maxGen {
+		return status.Errorf(codes.InvalidArgument, "generations must be between 1 and %d", len(generationEnds))
+	}
+
+	interval := defaultQuizHintInterval
+	if req.HintIntervalSeconds > 0 {
+		interval = time.Duration(req.HintIntervalSeconds) * time.Second
+	}
+
+	seed := uint64(req.Seed)
+	if seed == 0 {
+		seed = uint64(time.Now().UnixNano())
+	}
+
+	session := newQuizSession(newSessionID(), req.PlayerId, rounds, minGen, maxGen, seed)
+	s.quiz.start(session)
+	defer s.quiz.remove(session.id)
+
+	log.Printf("Quiz %s started for player %q (%d rounds)", session.id, session.playerID, session.rounds)
+
+	for round := 1; round <= session.rounds; round++ {
+		data, err := s.api.getPokemon(ctx, strconv.Itoa(session.nextID()))
+		if err != nil {
+			return status.Error(codes.Unavailable, fetchError(err))
+		}
+		answer := toPokemon(data)
+
+		silhouette, err := silhouetteURL(ctx, s.api.client, answer.ImageUrl)
+		if err != nil {
+			log.Printf("Quiz %s: failed to build silhouette for %s: %v", session.id, answer.Name, err)
+		}
+
+		session.startRound(answer, data.Species.Name, time.Now())
+		if err := stream.Send(&pb.QuizEvent{
+			SessionId: session.id,
+			Round:     int32(round),
+			Event: &pb.QuizEvent_RoundStart{RoundStart: &pb.QuizRoundStart{
+				TotalRounds:   int32(session.rounds),
+				SilhouetteUrl: silhouette,
+			}},
+		}); err != nil {
+			return err
+		}
+
+		if err := s.runQuizRound(stream, session, round, interval); err != nil {
+			return err
+		}
+
+		if err := stream.Send(&pb.QuizEvent{
+			SessionId: session.id,
+			Round:     int32(round),
+			Event:     &pb.QuizEvent_RoundResult{RoundResult: session.result()},
+		}); err != nil {
+			return err
+		}
+	}
+
+	summary := session.summary()
+	summary.Player = s.quiz.record(session)
+
+	log.Printf("Quiz %s finished: %d/%d solved, score %d", session.id, summary.SolvedRounds, summary.TotalRounds, summary.Score)
+
+	return stream.Send(&pb.QuizEvent{
+		SessionId: session.id,
+		Event:     &pb.QuizEvent_Summary{Summary: summary},
+	})
+}
+
+// runQuizRound sends a hint every interval until the round is answered or
+// runs out of hints.
+func (s *pokemonServer) runQuizRound(stream pb.PokemonService_PlayQuizServer, session *quizSession, round int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	done := session.roundDone()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-done:
+			return nil
+		case <-ticker.C:
+			hint := session.nextHint()
+			if hint == nil {
+				session.timeout(time.Now())
+				return nil
+			}
+			if err := stream.Send(&pb.QuizEvent{
+				SessionId: session.id,
+				Round:     int32(round),
+				Event:     &pb.QuizEvent_Hint{Hint: hint},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *pokemonServer) AnswerQuiz(ctx context.Context, req *pb.QuizAnswer) (*pb.QuizAnswerResponse, error) {
+	session := s.quiz.get(req.SessionId)
+	if session == nil {
+		return &pb.QuizAnswerResponse{
+			Success: false,
+			Message: "Quiz session not found",
+		}, nil
+	}
+
+	correct, err := session.guess(req.Guess, time.Now())
+	if err != nil {
+		return &pb.QuizAnswerResponse{
+			Success: false,
+			Message: "No round in progress",
+		}, nil
+	}
+
+	if !correct {
+		return &pb.QuizAnswerResponse{
+			Success: true,
+			Message: "Not quite, try again!",
+		}, nil
+	}
+
+	result := session.result()
+	return &pb.QuizAnswerResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("It's %s!", result.Answer.Name),
+		Correct:   true,
+		Points:    result.Points,
+		Score:     result.Score,
+		Streak:    result.Streak,
+		ElapsedMs: result.ElapsedMs,
+	}, nil
+}
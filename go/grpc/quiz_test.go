@@ -0,0 +1,100 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "grpc/proto"
+)
+
+func TestQuizSessionSeeded(t *testing.T) {
+	a := newQuizSession("a", "ash", 5, 1, 1, 42)
+	b := newQuizSession("b", "gary", 5, 1, 1, 42)
+
+	seen := make(map[int]bool)
+	for i := 0; i < 5; i++ {
+		idA, idB := a.nextID(), b.nextID()
+		if idA != idB {
+			t.Fatalf("round %d: same seed picked %d and %d", i+1, idA, idB)
+		}
+		if idA < 1 || idA > 151 {
+			t.Errorf("round %d: %d is outside generation I", i+1, idA)
+		}
+		if seen[idA] {
+			t.Errorf("round %d: %d picked twice", i+1, idA)
+		}
+		seen[idA] = true
+	}
+}
+
+func TestQuizSessionScoring(t *testing.T) {
+	q := newQuizSession("s", "ash", 2, 1, 1, 1)
+	start := time.Now()
+	pikachu := &pb.Pokemon{Id: 25, Name: "Pikachu", Types: []string{"Electric"}}
+
+	q.startRound(pikachu, "pikachu", start)
+	if hint := q.nextHint(); hint.Kind != "type" || hint.Text != "Electric type" {
+		t.Errorf("unexpected first hint: %v", hint)
+	}
+
+	if ok, _ := q.guess("raichu", start.Add(time.Second)); ok {
+		t.Error("wrong guess accepted")
+	}
+	if ok, _ := q.guess(" PIKACHU ", start.Add(3*time.Second)); !ok {
+		t.Fatal("correct guess rejected")
+	}
+	if _, err := q.guess("pikachu", start.Add(4*time.Second)); err == nil {
+		t.Error("expected error when guessing after the round ended")
+	}
+
+	result := q.result()
+	if result.Points != 75 || result.Streak != 1 || result.ElapsedMs != 3000 {
+		t.Errorf("unexpected result: %v", result)
+	}
+
+	q.startRound(&pb.Pokemon{Id: 1, Name: "Bulbasaur"}, "bulbasaur", start)
+	q.timeout(start.Add(time.Minute))
+
+	summary := q.summary()
+	if summary.Score != 75 || summary.SolvedRounds != 1 || summary.BestStreak != 1 {
+		t.Errorf("unexpected summary: %v", summary)
+	}
+	if q.result().Streak != 0 {
+		t.Error("streak should reset after an unsolved round")
+	}
+}
+
+func TestQuizSessionAcceptsSpeciesName(t *testing.T) {
+	q := newQuizSession("s", "ash", 2, 3, 3, 1)
+	start := time.Now()
+
+	// Deoxys' default form is named Deoxys-Normal
+	q.startRound(&pb.Pokemon{Id: 386, Name: "Deoxys-Normal"}, "deoxys", start)
+	if ok, _ := q.guess("Deoxys", start.Add(time.Second)); !ok {
+		t.Error("species name rejected")
+	}
+
+	q.startRound(&pb.Pokemon{Id: 386, Name: "Deoxys-Normal"}, "deoxys", start)
+	if ok, _ := q.guess("deoxys normal", start.Add(time.Second)); !ok {
+		t.Error("form name rejected")
+	}
+}
+
+func TestQuizManagerForgetsIdlePlayers(t *testing.T) {
+	m := newQuizManager()
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	m.record(newQuizSession("a", "ash", 1, 1, 1, 1))
+	now = now.Add(quizPlayerRetention / 2)
+	m.record(newQuizSession("b", "misty", 1, 1, 1, 1))
+	now = now.Add(quizPlayerRetention/2 + time.Hour)
+
+	stats := m.record(newQuizSession("c", "misty", 1, 1, 1, 1))
+	if stats.GamesPlayed != 2 {
+		t.Errorf("misty has %d games, want 2", stats.GamesPlayed)
+	}
+	if _, ok := m.players["ash"]; ok {
+		t.Error("idle player wasn't forgotten")
+	}
+}
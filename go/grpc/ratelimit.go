@@ -0,0 +1,362 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// Every call spends a request token, cached or not
+	requestRate  = 20
+	requestBurst = 40
+	// Calls that miss the cache also spend an upstream token per PokeAPI fetch
+	upstreamRate  = 2
+	upstreamBurst = 20
+
+	apiKeyHeader     = "x-api-key"
+	retryAfterHeader = "retry-after"
+	bucketIdleAfter  = 10 * time.Minute
+)
+
+var errRateLimited = errors.New("rate limited")
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// bucketLimiter keeps one token bucket per key.
+type bucketLimiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+func newBucketLimiter(rate, burst float64) *bucketLimiter {
+	return &bucketLimiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// take spends a token for key. When the bucket is empty it returns false and
+// how long until the next token is available.
+func (l *bucketLimiter) take(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// prune drops buckets that have been idle long enough to be full again.
+func (l *bucketLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < bucketIdleAfter {
+		return
+	}
+	l.lastPrune = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > bucketIdleAfter {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// rateLimiter limits calls per client, keyed by API key when one is sent
+// and by peer IP otherwise.
+type rateLimiter struct {
+	requests *bucketLimiter
+	upstream *bucketLimiter
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		requests: newBucketLimiter(requestRate, requestBurst),
+		upstream: newBucketLimiter(upstreamRate, upstreamBurst),
+	}
+}
+
+// callLimit is the per call state the PokeAPI client consults before going
+// upstream. It remembers whether the call was limited along the way.
+type callLimit struct {
+	limiter *rateLimiter
+	key     string
+
+	mu         sync.Mutex
+	retryAfter time.Duration
+	paced      atomic.Bool
+}
+
+type callLimitKey struct{}
+
+func withCallLimit(ctx context.Context, limit *callLimit) context.Context {
+	return context.WithValue(ctx, callLimitKey{}, limit)
+}
+
+func callLimitFromContext(ctx context.Context) *callLimit {
+	limit, _ := ctx.Value(callLimitKey{}).(*callLimit)
+	return limit
+}
+
+// allowUpstream spends an upstream token for a cache miss.
+func (c *callLimit) allowUpstream() bool {
+	ok, retryAfter := c.limiter.upstream.take(c.key, time.Now())
+	if !ok {
+		c.mu.Lock()
+		c.retryAfter = max(c.retryAfter, retryAfter)
+		c.mu.Unlock()
+	}
+	return ok
+}
+
+// pace makes the rest of the call wait for upstream tokens instead of
+// being refused, for calls like exports that fetch more than a burst.
+func (c *callLimit) pace() {
+	c.paced.Store(true)
+}
+
+// acquireUpstream spends an upstream token for a cache miss, waiting for
+// one if the call is paced.
+func (c *callLimit) acquireUpstream(ctx context.Context) error {
+	if !c.paced.Load() {
+		if !c.allowUpstream() {
+			return errRateLimited
+		}
+		return nil
+	}
+
+	for {
+		ok, retryAfter := c.limiter.upstream.take(c.key, time.Now())
+		if ok {
+			return nil
+		}
+		timer := time.NewTimer(retryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// limited returns how long to wait if any upstream fetch was refused.
+func (c *callLimit) limited() (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.retryAfter, c.retryAfter > 0
+}
+
+// begin spends a request token and returns the state for the call.
+func (l *rateLimiter) begin(key string) (*callLimit, time.Duration, bool) {
+	if ok, retryAfter := l.requests.take(key, time.Now()); !ok {
+		return nil, retryAfter, false
+	}
+	return &callLimit{limiter: l, key: key}, 0, true
+}
+
+// background returns a paced call limit for upstream fetches the server
+// makes on its own, like building the flavor text index. Each gets its
+// own upstream bucket.
+func (l *rateLimiter) background(name string) *callLimit {
+	limit := &callLimit{limiter: l, key: "server:" + name}
+	limit.pace()
+	return limit
+}
+
+func limitKey(apiKey, addr string) string {
+	if apiKey != "" {
+		return "key:" + apiKey
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return "ip:" + host
+	}
+	return "ip:" + addr
+}
+
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+func exhaustedStatus(retryAfter time.Duration) *status.Status {
+	st := status.New(codes.ResourceExhausted, "too many requests, retry in "+retryAfterSeconds(retryAfter)+"s")
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// Admin RPCs are authenticated and health checks come from Consul, so
+// neither is limited.
+func rateLimited(fullMethod string) bool {
+	return !strings.HasPrefix(fullMethod, "/pokemon.AdminService/") &&
+		!strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
+
+func grpcLimitKey(ctx context.Context) string {
+	var apiKey, addr string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+			apiKey = keys[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	return limitKey(apiKey, addr)
+}
+
+// servedResponse reports whether a unary response has results worth
+// keeping even though some upstream fetches were refused, like a batch
+// where only some items were limited.
+func servedResponse(resp any) bool {
+	r, ok := resp.(interface{ GetSuccess() bool })
+	return ok && r.GetSuccess()
+}
+
+// A unary call whose upstream fetches were refused only fails with
+// ResourceExhausted when nothing was served. Otherwise it keeps its
+// results, e.g. a batch reports the refused items, and the retry-after
+// trailer says when to try the rest.
+func (l *rateLimiter) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !rateLimited(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	limit, retryAfter, ok := l.begin(grpcLimitKey(ctx))
+	if !ok {
+		grpc.SetTrailer(ctx, metadata.Pairs(retryAfterHeader, retryAfterSeconds(retryAfter)))
+		return nil, exhaustedStatus(retryAfter).Err()
+	}
+
+	resp, err := handler(withCallLimit(ctx, limit), req)
+	if retryAfter, limited := limit.limited(); limited {
+		grpc.SetTrailer(ctx, metadata.Pairs(retryAfterHeader, retryAfterSeconds(retryAfter)))
+		if err != nil || !servedResponse(resp) {
+			return nil, exhaustedStatus(retryAfter).Err()
+		}
+	}
+	return resp, err
+}
+
+// limitedServerStream carries the call limit.
+type limitedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *limitedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// A limited stream always fails with ResourceExhausted, even after sending
+// some messages, since a client can't tell a partial stream from a
+// complete one. Streams that fetch more than a burst, like StreamPokedex
+// and ExportPokedex, pace themselves instead of being limited.
+func (l *rateLimiter) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !rateLimited(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	limit, retryAfter, ok := l.begin(grpcLimitKey(ss.Context()))
+	if !ok {
+		ss.SetTrailer(metadata.Pairs(retryAfterHeader, retryAfterSeconds(retryAfter)))
+		return exhaustedStatus(retryAfter).Err()
+	}
+
+	err := handler(srv, &limitedServerStream{ServerStream: ss, ctx: withCallLimit(ss.Context(), limit)})
+	if retryAfter, limited := limit.limited(); limited {
+		ss.SetTrailer(metadata.Pairs(retryAfterHeader, retryAfterSeconds(retryAfter)))
+		return exhaustedStatus(retryAfter).Err()
+	}
+	return err
+}
+
+// connectInterceptor applies the same limits to gRPC-Web and Connect calls.
+func (l *rateLimiter) connectInterceptor() connect.Interceptor {
+	return &connectRateLimiter{limiter: l}
+}
+
+type connectRateLimiter struct {
+	limiter *rateLimiter
+}
+
+func (c *connectRateLimiter) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		limit, retryAfter, ok := c.limiter.begin(limitKey(req.Header().Get(apiKeyHeader), req.Peer().Addr))
+		if !ok {
+			return nil, connectExhausted(retryAfter)
+		}
+
+		resp, err := next(withCallLimit(ctx, limit), req)
+		if retryAfter, limited := limit.limited(); limited {
+			if err != nil || !servedResponse(resp.Any()) {
+				return nil, connectExhausted(retryAfter)
+			}
+			resp.Trailer().Set(retryAfterHeader, retryAfterSeconds(retryAfter))
+		}
+		return resp, err
+	}
+}
+
+func (c *connectRateLimiter) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (c *connectRateLimiter) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		limit, retryAfter, ok := c.limiter.begin(limitKey(conn.RequestHeader().Get(apiKeyHeader), conn.Peer().Addr))
+		if !ok {
+			return connectExhausted(retryAfter)
+		}
+
+		err := next(withCallLimit(ctx, limit), conn)
+		if retryAfter, limited := limit.limited(); limited {
+			return connectExhausted(retryAfter)
+		}
+		return err
+	}
+}
+
+func connectExhausted(retryAfter time.Duration) error {
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New(exhaustedStatus(retryAfter).Message()))
+	err.Meta().Set(retryAfterHeader, retryAfterSeconds(retryAfter))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	pb "grpc/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBucketLimiter(t *testing.T) {
+	l := newBucketLimiter(2, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.take("ip:10.0.0.1", now); !ok {
+			t.Fatalf("request %d within burst was limited", i+1)
+		}
+	}
+
+	ok, retryAfter := l.take("ip:10.0.0.1", now)
+	if ok {
+		t.Fatal("request over burst was allowed")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Errorf("expected retry after 500ms, got %v", retryAfter)
+	}
+
+	if ok, _ := l.take("ip:10.0.0.2", now); !ok {
+		t.Error("other peers should have their own bucket")
+	}
+
+	if ok, _ := l.take("ip:10.0.0.1", now.Add(500*time.Millisecond)); !ok {
+		t.Error("bucket should refill over time")
+	}
+}
+
+func TestLimitKey(t *testing.T) {
+	if got := limitKey("", "192.168.1.5:53211"); got != "ip:192.168.1.5" {
+		t.Errorf("unexpected peer key %q", got)
+	}
+	if got := limitKey("secret", "192.168.1.5:53211"); got != "key:secret" {
+		t.Errorf("unexpected API key %q", got)
+	}
+}
+
+// coldServer serves Pokemon 1-100 from an empty cache.
+func coldServer(t *testing.T) *pokemonServer {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/pokemon/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"id":%d,"name":"pokemon-%d"}`, id, id)
+	}))
+	t.Cleanup(upstream.Close)
+	return &pokemonServer{api: newPokeAPI(upstream.URL), trends: newTrendStore("")}
+}
+
+func TestBatchLargerThanUpstreamBurst(t *testing.T) {
+	s := coldServer(t)
+	limiter := newRateLimiter()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pokemon.PokemonService/GetPokemons"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return s.GetPokemons(ctx, req.(*pb.PokemonsRequest))
+	}
+
+	queries := make([]string, upstreamBurst+10)
+	for i := range queries {
+		queries[i] = strconv.Itoa(i + 1)
+	}
+	resp, err := limiter.UnaryServerInterceptor(context.Background(), &pb.PokemonsRequest{Queries: queries}, info, handler)
+	if err != nil {
+		t.Fatalf("batch failed as a whole: %v", err)
+	}
+
+	found, limited := 0, 0
+	for i, r := range resp.(*pb.PokemonsResponse).Results {
+		switch {
+		case r.Success:
+			found++
+			if r.Pokemon.Id != int32(i+1) {
+				t.Errorf("result %d is Pokemon %d", i, r.Pokemon.Id)
+			}
+		case r.Message == fetchError(errRateLimited):
+			limited++
+		default:
+			t.Errorf("result %d: unexpected failure %q", i, r.Message)
+		}
+	}
+	// The bucket may refill by a token while the batch runs
+	if found < upstreamBurst || found > upstreamBurst+1 || found+limited != len(queries) {
+		t.Errorf("found %d and limited %d of %d", found, limited, len(queries))
+	}
+
+	// With the bucket empty, a call that can't serve anything is limited
+	_, err = limiter.UnaryServerInterceptor(context.Background(), &pb.PokemonsRequest{Queries: []string{"99"}}, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got %v, want ResourceExhausted", err)
+	}
+}
+
+type pokedexStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*pb.Pokemon
+	trailer metadata.MD
+}
+
+func (s *pokedexStream) Context() context.Context  { return s.ctx }
+func (s *pokedexStream) SetTrailer(md metadata.MD) { s.trailer = md }
+
+func (s *pokedexStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m.(*pb.Pokemon))
+	return nil
+}
+
+func TestStreamPokedexWaitsForUpstreamTokens(t *testing.T) {
+	s := coldServer(t)
+	// 60 fetches against a burst of 2, refilling quickly
+	limiter := &rateLimiter{
+		requests: newBucketLimiter(requestRate, requestBurst),
+		upstream: newBucketLimiter(500, 2),
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/pokemon.PokemonService/StreamPokedex"}
+	req := &pb.PokedexRequest{FromId: 1, ToId: 60}
+	handler := func(srv any, ss grpc.ServerStream) error {
+		return s.StreamPokedex(req, &pokemonServiceStreamPokedexServer{ss})
+	}
+
+	stream := &pokedexStream{ctx: context.Background()}
+	if err := limiter.StreamServerInterceptor(nil, stream, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.sent) != 60 {
+		t.Fatalf("sent %d entries, want 60", len(stream.sent))
+	}
+	for i, p := range stream.sent {
+		if p.Id != int32(i+1) {
+			t.Fatalf("entry %d is Pokemon %d", i, p.Id)
+		}
+	}
+}
+
+func TestLimitedStreamIsExhausted(t *testing.T) {
+	limiter := newRateLimiter()
+	info := &grpc.StreamServerInfo{FullMethod: "/pokemon.PokemonService/PlayQuiz"}
+	// Sends a message, then runs out of upstream tokens
+	handler := func(srv any, ss grpc.ServerStream) error {
+		if err := ss.SendMsg(&pb.Pokemon{Id: 1}); err != nil {
+			return err
+		}
+		limit := callLimitFromContext(ss.Context())
+		for limit.allowUpstream() {
+		}
+		return status.Error(codes.Unavailable, "refused")
+	}
+
+	stream := &pokedexStream{ctx: context.Background()}
+	err := limiter.StreamServerInterceptor(nil, stream, info, handler)
+	if status.Code(err) != codes.ResourceExhausted || len(stream.sent) != 1 {
+		t.Errorf("got %v after %d entries, want ResourceExhausted", err, len(stream.sent))
+	}
+	if got := stream.trailer.Get(retryAfterHeader); len(got) != 1 || got[0] == "0" {
+		t.Errorf("got retry-after trailer %v", got)
+	}
+}
+
+type pokemonServiceStreamPokedexServer struct {
+	grpc.ServerStream
+}
+
+func (s *pokemonServiceStreamPokedexServer) Send(p *pb.Pokemon) error {
+	return s.ServerStream.SendMsg(p)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+)
+
+// silhouetteURL downloads a Pokemon artwork PNG and returns a data URL of
+// its black silhouette, so the answer can't be read off the image URL.
+func silhouetteURL(ctx context.Context, client *http.Client, imageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("image error: status code %d", resp.StatusCode)
+	}
+
+	img, err := png.Decode(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, silhouette(img)); err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// silhouette paints every visible pixel black and leaves the rest transparent.
+func silhouette(img image.Image) *image.Paletted {
+	bounds := img.Bounds()
+	out := image.NewPaletted(bounds, color.Palette{color.Transparent, color.Black})
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a > 0x8000 {
+				out.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"strings"
+)
+
+// PokeAPI species response
+type PokeAPISpecies struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	FlavorTextEntries []struct {
+		FlavorText string `json:"flavor_text"`
+		Language   struct {
+			Name string `json:"name"`
+		} `json:"language"`
+		Version struct {
+			Name string `json:"name"`
+		} `json:"version"`
+	} `json:"flavor_text_entries"`
+	EggGroups []struct {
+		Name string `json:"name"`
+	} `json:"egg_groups"`
+	GenderRate    int  `json:"gender_rate"` // Chance of being female in eighths, -1 for genderless
+	HatchCounter  int  `json:"hatch_counter"`
+	CaptureRate   int  `json:"capture_rate"`
+	BaseHappiness *int `json:"base_happiness"`
+	IsBaby        bool `json:"is_baby"`
+	IsLegendary   bool `json:"is_legendary"`
+	IsMythical    bool `json:"is_mythical"`
+	Color         struct {
+		Name string `json:"name"`
+	} `json:"color"`
+	Shape *struct {
+		Name string `json:"name"`
+	} `json:"shape"`
+	Habitat *struct {
+		Name string `json:"name"`
+	} `json:"habitat"`
+	EvolutionChain struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+	Varieties []struct {
+		IsDefault bool `json:"is_default"`
+		Pokemon   struct {
+			Name string `json:"name"`
+		} `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// getSpecies fetches a species by ID or name.
+func (a *pokeAPI) getSpecies(ctx context.Context, query string) (*PokeAPISpecies, error) {
+	var data PokeAPISpecies
+	if err := a.get(ctx, "pokemon-species/"+query, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// cleanFlavorText collapses the line and page breaks PokeAPI keeps from the games.
+func cleanFlavorText(text string) string {
+	// Soft hyphens mark words the games split across lines
+	text = strings.ReplaceAll(text, "\u00ad\n", "")
+	text = strings.ReplaceAll(text, "\u00ad", "")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	pb "grpc/proto"
+)
+
+// spreadValues maps a StatSpread to stat names. A missing spread gives
+// every stat the fallback value.
+func spreadValues(spread *pb.StatSpread, fallback int) map[string]int {
+	if spread == nil {
+		values := make(map[string]int, len(statNames))
+		for _, stat := range statNames {
+			values[stat] = fallback
+		}
+		return values
+	}
+	return map[string]int{
+		"hp":              int(spread.Hp),
+		"attack":          int(spread.Attack),
+		"defense":         int(spread.Defense),
+		"special-attack":  int(spread.SpecialAttack),
+		"special-defense": int(spread.SpecialDefense),
+		"speed":           int(spread.Speed),
+	}
+}
+
+// statInputs validates the level, nature and EVs shared by both calculators.
+func statInputs(level int32, nature string, evs *pb.StatSpread) (int, string, map[string]int, error) {
+	if level == 0 {
+		level = defaultLevel
+	}
+	if level < 1 || level > maxLevel {
+		return 0, "", nil, fmt.Errorf("level must be between 1 and %d", maxLevel)
+	}
+	n, err := parseNature(nature)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	values := spreadValues(evs, 0)
+	if err := validateEVs(values); err != nil {
+		return 0, "", nil, err
+	}
+	return int(level), n, values, nil
+}
+
+func (s *pokemonServer) CalculateStats(ctx context.Context, req *pb.StatCalcRequest) (*pb.StatCalcResponse, error) {
+	query := normalizeQuery(req.Query)
+
+	if query == "" {
+		return &pb.StatCalcResponse{
+			Success: false,
+			Message: "Please enter a Pokemon name or ID",
+		}, nil
+	}
+
+	ivs := spreadValues(req.Ivs, maxIV)
+	level, nature, evs, err := statInputs(req.Level, req.Nature, req.Evs)
+	if err == nil {
+		err = validateIVs(ivs)
+	}
+	if err != nil {
+		return &pb.StatCalcResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid input: %v", err),
+		}, nil
+	}
+
+	log.Printf("Calculating stats: %s", query)
+
+	data, err := s.api.getPokemon(ctx, query)
+	if err != nil {
+		return &pb.StatCalcResponse{
+			Success: false,
+			Message: fetchError(err),
+		}, nil
+	}
+
+	base := baseStats(data)
+	stats := make([]*pb.CalculatedStat, len(statNames))
+	for i, stat := range statNames {
+		modifier := natureModifier(nature, stat)
+		if stat == "hp" {
+			modifier = neutralNature
+		}
+		stats[i] = &pb.CalculatedStat{
+			Name:          stat,
+			Base:          int32(base[stat]),
+			Iv:            int32(ivs[stat]),
+			Ev:            int32(evs[stat]),
+			Value:         int32(calcStat(stat, base[stat], ivs[stat], evs[stat], level, modifier)),
+			NaturePercent: int32(modifier),
+		}
+	}
+
+	name := strings.Title(data.Name)
+	return &pb.StatCalcResponse{
+		Success: true,
+		Message: fmt.Sprintf("Level %d %s with a %s nature (%s)", level, name, strings.Title(nature), natureSummary(nature)),
+		Pokemon: name,
+		Level:   int32(level),
+		Nature:  strings.Title(nature),
+		Stats:   stats,
+	}, nil
+}
+
+func (s *pokemonServer) EstimateIVs(ctx context.Context, req *pb.IVEstimateRequest) (*pb.IVEstimateResponse, error) {
+	query := normalizeQuery(req.Query)
+
+	if query == "" {
+		return &pb.IVEstimateResponse{
+			Success: false,
+			Message: "Please enter a Pokemon name or ID",
+		}, nil
+	}
+	if req.Stats == nil {
+		return &pb.IVEstimateResponse{
+			Success: false,
+			Message: "Please enter the Pokemon's stats",
+		}, nil
+	}
+
+	level, nature, evs, err := statInputs(req.Level, req.Nature, req.Evs)
+	if err != nil {
+		return &pb.IVEstimateResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid input: %v", err),
+		}, nil
+	}
+
+	log.Printf("Estimating IVs: %s", query)
+
+	data, err := s.api.getPokemon(ctx, query)
+	if err != nil {
+		return &pb.IVEstimateResponse{
+			Success: false,
+			Message: fetchError(err),
+		}, nil
+	}
+
+	base := baseStats(data)
+	observed := spreadValues(req.Stats, 0)
+	ranges := make([]*pb.IVRange, len(statNames))
+	var impossible []string
+	for i, stat := range statNames {
+		modifier := natureModifier(nature, stat)
+		if stat == "hp" {
+			modifier = neutralNature
+		}
+		low, high, ok := ivRange(stat, base[stat], evs[stat], level, modifier, observed[stat])
+		ranges[i] = &pb.IVRange{Name: stat, Possible: ok}
+		if ok {
+			ranges[i].MinIv, ranges[i].MaxIv = int32(low), int32(high)
+		} else {
+			impossible = append(impossible, stat)
+		}
+	}
+
+	name := strings.Title(data.Name)
+	resp := &pb.IVEstimateResponse{
+		Success: len(impossible) == 0,
+		Message: fmt.Sprintf("Estimated IVs for level %d %s", level, name),
+		Pokemon: name,
+		Level:   int32(level),
+		Nature:  strings.Title(nature),
+		Ivs:     ranges,
+	}
+	if len(impossible) > 0 {
+		resp.Message = fmt.Sprintf("No IVs give those %s stats at level %d with a %s nature, check the level, nature and EVs",
+			strings.Join(impossible, ", "), level, strings.Title(nature))
+	}
+	return resp, nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import "fmt"
+
+const (
+	defaultLevel  = 50
+	maxLevel      = 100
+	maxIV         = 31
+	maxEV         = 252
+	maxTotalEVs   = 510
+	neutralNature = 100
+)
+
+// natures maps each nature to the stats it raises and lowers by 10%.
+// Natures raising and lowering the same stat are neutral.
+var natures = map[string][2]string{
+	"hardy":   {"attack", "attack"},
+	"lonely":  {"attack", "defense"},
+	"brave":   {"attack", "speed"},
+	"adamant": {"attack", "special-attack"},
+	"naughty": {"attack", "special-defense"},
+	"bold":    {"defense", "attack"},
+	"docile":  {"defense", "defense"},
+	"relaxed": {"defense", "speed"},
+	"impish":  {"defense", "special-attack"},
+	"lax":     {"defense", "special-defense"},
+	"timid":   {"speed", "attack"},
+	"hasty":   {"speed", "defense"},
+	"serious": {"speed", "speed"},
+	"jolly":   {"speed", "special-attack"},
+	"naive":   {"speed", "special-defense"},
+	"modest":  {"special-attack", "attack"},
+	"mild":    {"special-attack", "defense"},
+	"quiet":   {"special-attack", "speed"},
+	"bashful": {"special-attack", "special-attack"},
+	"rash":    {"special-attack", "special-defense"},
+	"calm":    {"special-defense", "attack"},
+	"gentle":  {"special-defense", "defense"},
+	"sassy":   {"special-defense", "speed"},
+	"careful": {"special-defense", "special-attack"},
+	"quirky":  {"special-defense", "special-defense"},
+}
+
+// natureModifier returns a nature's modifier for stat in percent.
+func natureModifier(nature, stat string) int {
+	effect := natures[nature]
+	switch {
+	case effect[0] == effect[1]:
+		return neutralNature
+	case effect[0] == stat:
+		return 110
+	case effect[1] == stat:
+		return 90
+	}
+	return neutralNature
+}
+
+// parseNature normalizes a nature name, treating an empty one as neutral.
+func parseNature(name string) (string, error) {
+	nature := normalizeQuery(name)
+	if nature == "" {
+		return "hardy", nil
+	}
+	if _, ok := natures[nature]; !ok {
+		return "", fmt.Errorf("unknown nature %q", name)
+	}
+	return nature, nil
+}
+
+// validateEVs checks each stat's EVs and their total against the game's limits.
+func validateEVs(evs map[string]int) error {
+	total := 0
+	for _, stat := range statNames {
+		ev := evs[stat]
+		if ev < 0 || ev > maxEV {
+			return fmt.Errorf("%s EVs must be between 0 and %d", stat, maxEV)
+		}
+		total += ev
+	}
+	if total > maxTotalEVs {
+		return fmt.Errorf("EVs add up to %d, the most a Pokemon can have is %d", total, maxTotalEVs)
+	}
+	return nil
+}
+
+// validateIVs checks each stat's IVs are in range.
+func validateIVs(ivs map[string]int) error {
+	for _, stat := range statNames {
+		if iv := ivs[stat]; iv < 0 || iv > maxIV {
+			return fmt.Errorf("%s IVs must be between 0 and %d", stat, maxIV)
+		}
+	}
+	return nil
+}
+
+// ivRange returns the IVs that give observed, or ok false when none do.
+func ivRange(stat string, base, ev, level, nature, observed int) (low, high int, ok bool) {
+	low, high = -1, -1
+	for iv := 0; iv <= maxIV; iv++ {
+		if calcStat(stat, base, iv, ev, level, nature) == observed {
+			if low < 0 {
+				low = iv
+			}
+			high = iv
+		}
+	}
+	return low, high, low >= 0
+}
+
+// natureSummary describes a nature's effect, e.g. "+speed -special-attack".
+func natureSummary(nature string) string {
+	effect := natures[nature]
+	if effect[0] == effect[1] {
+		return "neutral"
+	}
+	return fmt.Sprintf("+%s -%s", effect[0], effect[1])
+}
+
+// calcStat returns the actual stat at a level, using the formula from
+// Generation III onwards. nature is the nature modifier in percent (110, 100
+// or 90) and is ignored for HP.
+func calcStat(stat string, base, iv, ev, level, nature int) int {
+	raw := (2*base + iv + ev/4) * level / 100
+	if stat == "hp" {
+		// Shedinja always has exactly 1 HP
+		if base == 1 {
+			return 1
+		}
+		return raw + level + 10
+	}
+	return (raw + 5) * nature / 100
+}
+
+// baseStats maps stat names to base stats for a PokeAPI response.
+func baseStats(data *PokeAPIResponse) map[string]int {
+	stats := make(map[string]int, len(data.Stats))
+	for _, s := range data.Stats {
+		stats[s.Stat.Name] = s.BaseStat
+	}
+	return stats
+}
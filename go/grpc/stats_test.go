@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestCalcStatWithNature(t *testing.T) {
+	// Level 50 Jolly Garchomp with 252 speed EVs
+	if got := calcStat("speed", 102, maxIV, 252, 50, natureModifier("jolly", "speed")); got != 169 {
+		t.Errorf("speed = %d, want 169", got)
+	}
+	if got := calcStat("special-attack", 80, maxIV, 0, 50, natureModifier("jolly", "special-attack")); got != 90 {
+		t.Errorf("special attack = %d, want 90", got)
+	}
+	if got := natureModifier("hardy", "attack"); got != neutralNature {
+		t.Errorf("hardy attack modifier = %d, want %d", got, neutralNature)
+	}
+}
+
+func TestValidateEVs(t *testing.T) {
+	valid := map[string]int{"attack": 252, "speed": 252, "hp": 6}
+	if err := validateEVs(valid); err != nil {
+		t.Errorf("expected %v to be valid: %v", valid, err)
+	}
+	if err := validateEVs(map[string]int{"attack": 253}); err == nil {
+		t.Error("expected more than 252 EVs in a stat to fail")
+	}
+	if err := validateEVs(map[string]int{"attack": 252, "speed": 252, "hp": 8}); err == nil {
+		t.Error("expected more than 510 EVs in total to fail")
+	}
+}
+
+func TestIVRange(t *testing.T) {
+	// At level 50 two IVs share each stat value
+	low, high, ok := ivRange("speed", 102, 0, 50, neutralNature, 122)
+	if !ok || low != 30 || high != 31 {
+		t.Errorf("ivRange = %d-%d (%v), want 30-31", low, high, ok)
+	}
+	for iv := 0; iv <= maxIV; iv++ {
+		observed := calcStat("hp", 108, iv, 0, 100, neutralNature)
+		if low, high, ok := ivRange("hp", 108, 0, 100, neutralNature, observed); !ok || low != iv || high != iv {
+			t.Errorf("level 100 HP %d: ivRange = %d-%d, want %d", observed, low, high, iv)
+		}
+	}
+	if _, _, ok := ivRange("speed", 102, 0, 50, neutralNature, 500); ok {
+		t.Error("expected an impossible stat to have no IVs")
+	}
+}
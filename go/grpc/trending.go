@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "grpc/proto"
+)
+
+const (
+	trendBucketSize     = 5 * time.Minute
+	trendRetention      = 14 * 24 * time.Hour // Two weeks, so a week can be compared with the one before
+	trendSaveInterval   = time.Minute
+	defaultTrendingSize = 10
+	maxTrendingSize     = 100
+)
+
+var trendWindows = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+	"week": 7 * 24 * time.Hour,
+}
+
+// trendStore counts Pokemon lookups in fixed time buckets. Buckets older
+// than trendRetention are dropped, and the counts are saved to path so
+// trends survive restarts.
+type trendStore struct {
+	path string
+	now  func() time.Time
+
+	mu      sync.Mutex
+	dirty   bool
+	buckets map[int64]map[int]int // Bucket start (Unix seconds) -> Pokemon ID -> lookups
+	names   map[int]string
+}
+
+// trendFile is the saved form of a trendStore. JSON objects need string keys.
+type trendFile struct {
+	Buckets map[string]map[string]int `json:"buckets"`
+	Names   map[string]string         `json:"names"`
+}
+
+func newTrendStore(path string) *trendStore {
+	return &trendStore{
+		path:    path,
+		now:     time.Now,
+		buckets: make(map[int64]map[int]int),
+		names:   make(map[int]string),
+	}
+}
+
+// record counts one lookup of a Pokemon.
+func (t *trendStore) record(id int32, name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start := t.now().Truncate(trendBucketSize).Unix()
+	if t.buckets[start] == nil {
+		t.buckets[start] = make(map[int]int)
+	}
+	t.buckets[start][int(id)]++
+	t.names[int(id)] = name
+	t.dirty = true
+}
+
+// counts sums lookups per Pokemon over the window ending `ago` before now.
+// The caller must hold t.mu.
+func (t *trendStore) counts(window, ago time.Duration) map[int]int {
+	end := t.now().Truncate(trendBucketSize).Add(trendBucketSize - ago)
+	start := end.Add(-window)
+
+	counts := make(map[int]int)
+	for bucket, ids := range t.buckets {
+		if at := time.Unix(bucket, 0); !at.Before(start) && at.Before(end) {
+			for id, n := range ids {
+				counts[id] += n
+			}
+		}
+	}
+	return counts
+}
+
+// rankByLookups orders Pokemon by lookups, most first, with ties broken by ID.
+func rankByLookups(counts map[int]int) []int {
+	ids := make([]int, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if counts[ids[i]] != counts[ids[j]] {
+			return counts[ids[i]] > counts[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+// top returns the most looked up Pokemon in window, with their rank in the
+// window before it for comparison.
+func (t *trendStore) top(window time.Duration, limit int) []*pb.TrendingPokemon {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := t.counts(window, 0)
+	previousRanks := make(map[int]int)
+	for i, id := range rankByLookups(t.counts(window, window)) {
+		previousRanks[id] = i + 1
+	}
+
+	ids := rankByLookups(current)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	trending := make([]*pb.TrendingPokemon, len(ids))
+	for i, id := range ids {
+		entry := &pb.TrendingPokemon{
+			Rank:    int32(i + 1),
+			Id:      int32(id),
+			Name:    t.names[id],
+			Lookups: int32(current[id]),
+		}
+		if previous, ok := previousRanks[id]; ok {
+			entry.PreviousRank = int32(previous)
+			entry.Movement = int32(previous - (i + 1))
+		} else {
+			entry.New = true
+		}
+		trending[i] = entry
+	}
+	return trending
+}
+
+// prune drops buckets older than trendRetention. The caller must hold t.mu.
+func (t *trendStore) prune() {
+	cutoff := t.now().Add(-trendRetention).Unix()
+	for bucket := range t.buckets {
+		if bucket < cutoff {
+			delete(t.buckets, bucket)
+			t.dirty = true
+		}
+	}
+}
+
+// load restores counts saved by an earlier run. A missing file isn't an error.
+func (t *trendStore) load() error {
+	data, err := os.ReadFile(t.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file trendFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for bucket, ids := range file.Buckets {
+		start, err := strconv.ParseInt(bucket, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid bucket %q", bucket)
+		}
+		t.buckets[start] = make(map[int]int, len(ids))
+		for id, n := range ids {
+			pokemonID, err := strconv.Atoi(id)
+			if err != nil {
+				return fmt.Errorf("invalid Pokemon ID %q", id)
+			}
+			t.buckets[start][pokemonID] = n
+		}
+	}
+	for id, name := range file.Names {
+		if pokemonID, err := strconv.Atoi(id); err == nil {
+			t.names[pokemonID] = name
+		}
+	}
+	t.prune()
+	return nil
+}
+
+// save writes the counts to disk if they changed since the last save.
+// The file is replaced atomically so a crash can't leave it half written.
+func (t *trendStore) save() error {
+	t.mu.Lock()
+	t.prune()
+	if !t.dirty {
+		t.mu.Unlock()
+		return nil
+	}
+	file := trendFile{
+		Buckets: make(map[string]map[string]int, len(t.buckets)),
+		Names:   make(map[string]string),
+	}
+	for bucket, ids := range t.buckets {
+		counts := make(map[string]int, len(ids))
+		for id, n := range ids {
+			counts[strconv.Itoa(id)] = n
+			file.Names[strconv.Itoa(id)] = t.names[id]
+		}
+		file.Buckets[strconv.FormatInt(bucket, 10)] = counts
+	}
+	t.dirty = false
+	t.mu.Unlock()
+
+	data, err := json.Marshal(file)
+	if err == nil {
+		err = writeFileAtomic(t.path, data)
+	}
+	if err != nil {
+		t.mu.Lock()
+		t.dirty = true
+		t.mu.Unlock()
+	}
+	return err
+}
+
+// run saves the counts every trendSaveInterval until ctx is done, then
+// saves one last time.
+func (t *trendStore) run(ctx context.Context) {
+	ticker := time.NewTicker(trendSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if err := t.save(); err != nil {
+				log.Printf("Failed to save trending counts: %v", err)
+			}
+			return
+		}
+		if err := t.save(); err != nil {
+			log.Printf("Failed to save trending counts: %v", err)
+		}
+	}
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *pokemonServer) GetTrending(ctx context.Context, req *pb.TrendingRequest) (*pb.TrendingResponse, error) {
+	name := req.Window
+	if name == "" {
+		name = "day"
+	}
+	window, ok := trendWindows[name]
+	if !ok {
+		return &pb.TrendingResponse{
+			Success: false,
+			Message: "Window must be \"hour\", \"day\" or \"week\"",
+		}, nil
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultTrendingSize
+	}
+	limit = min(limit, maxTrendingSize)
+
+	trending := s.trends.top(window, limit)
+	if len(trending) == 0 {
+		return &pb.TrendingResponse{
+			Success: false,
+			Message: fmt.Sprintf("No Pokemon were looked up in the last %s", name),
+			Window:  name,
+		}, nil
+	}
+
+	return &pb.TrendingResponse{
+		Success: true,
+		Message: fmt.Sprintf("Top %d Pokemon in the last %s", len(trending), name),
+		Window:  name,
+		Pokemon: trending,
+	}, nil
+}
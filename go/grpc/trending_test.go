@@ -0,0 +1,73 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTrendStoreTop(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	trends := newTrendStore(filepath.Join(t.TempDir(), "trending.json"))
+	trends.now = func() time.Time { return now }
+
+	// The hour before: Bulbasaur leads Pikachu
+	now = now.Add(-90 * time.Minute)
+	for i := 0; i < 3; i++ {
+		trends.record(1, "Bulbasaur")
+	}
+	trends.record(25, "Pikachu")
+
+	// This hour: Pikachu overtakes, Mew is new
+	now = now.Add(90 * time.Minute)
+	for i := 0; i < 4; i++ {
+		trends.record(25, "Pikachu")
+	}
+	trends.record(1, "Bulbasaur")
+	trends.record(151, "Mew")
+
+	top := trends.top(time.Hour, 10)
+	if len(top) != 3 {
+		t.Fatalf("expected 3 Pokemon, got %v", top)
+	}
+	if p := top[0]; p.Name != "Pikachu" || p.Lookups != 4 || p.PreviousRank != 2 || p.Movement != 1 {
+		t.Errorf("unexpected first place: %v", p)
+	}
+	if p := top[1]; p.Name != "Bulbasaur" || p.Movement != -1 {
+		t.Errorf("unexpected second place: %v", p)
+	}
+	if p := top[2]; p.Name != "Mew" || !p.New || p.PreviousRank != 0 {
+		t.Errorf("unexpected third place: %v", p)
+	}
+
+	if day := trends.top(24*time.Hour, 1); len(day) != 1 || day[0].Name != "Pikachu" || day[0].Lookups != 5 {
+		t.Errorf("unexpected day top: %v", day)
+	}
+}
+
+func TestTrendStorePersists(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "trending.json")
+
+	trends := newTrendStore(path)
+	trends.now = func() time.Time { return now }
+	trends.record(25, "Pikachu")
+	// Expired buckets aren't saved
+	now = now.Add(-trendRetention - time.Hour)
+	trends.record(1, "Bulbasaur")
+	now = now.Add(trendRetention + time.Hour)
+
+	if err := trends.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := newTrendStore(path)
+	restored.now = func() time.Time { return now }
+	if err := restored.load(); err != nil {
+		t.Fatal(err)
+	}
+	top := restored.top(time.Hour, 10)
+	if len(top) != 1 || top[0].Name != "Pikachu" || top[0].Lookups != 1 {
+		t.Errorf("unexpected restored counts: %v", top)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	pb "grpc/proto"
+	"grpc/proto/protoconnect"
+
+	"connectrpc.com/connect"
+	"github.com/rs/cors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// connectServer exposes pokemonServer through Connect handlers, which speak
+// the gRPC-Web and Connect protocols browsers can use. Unary methods are
+// promoted from pokemonServer as-is; streaming methods are adapted below.
+type connectServer struct {
+	*pokemonServer
+}
+
+func (c connectServer) PlayQuiz(ctx context.Context, req *pb.QuizRequest, stream *connect.ServerStream[pb.QuizEvent]) error {
+	return c.pokemonServer.PlayQuiz(req, newConnectStream(ctx, stream))
+}
+
+func (c connectServer) StreamPokedex(ctx context.Context, req *pb.PokedexRequest, stream *connect.ServerStream[pb.Pokemon]) error {
+	return c.pokemonServer.StreamPokedex(req, newConnectStream(ctx, stream))
+}
+
+func (c connectServer) ExportPokedex(ctx context.Context, req *pb.ExportRequest, stream *connect.ServerStream[pb.ExportChunk]) error {
+	return c.pokemonServer.ExportPokedex(req, newConnectStream(ctx, stream))
+}
+
+// connectStream lets a grpc-go server streaming method send on a Connect stream.
+type connectStream[T any] struct {
+	ctx    context.Context
+	stream *connect.ServerStream[T]
+}
+
+func newConnectStream[T any](ctx context.Context, stream *connect.ServerStream[T]) *connectStream[T] {
+	return &connectStream[T]{ctx: ctx, stream: stream}
+}
+
+func (s *connectStream[T]) Send(msg *T) error {
+	return s.stream.Send(msg)
+}
+
+func (s *connectStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectStream[T]) SetHeader(md metadata.MD) error {
+	for k, v := range md {
+		for _, value := range v {
+			s.stream.ResponseHeader().Add(k, value)
+		}
+	}
+	return nil
+}
+
+// SendHeader only records the headers, Connect flushes them with the first message.
+func (s *connectStream[T]) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *connectStream[T]) SetTrailer(md metadata.MD) {
+	for k, v := range md {
+		for _, value := range v {
+			s.stream.ResponseTrailer().Add(k, value)
+		}
+	}
+}
+
+func (s *connectStream[T]) SendMsg(m any) error {
+	msg, ok := m.(*T)
+	if !ok {
+		return errors.New("unexpected message type")
+	}
+	return s.stream.Send(msg)
+}
+
+func (s *connectStream[T]) RecvMsg(any) error {
+	return errors.New("server streams can't receive messages")
+}
+
+// statusInterceptor converts grpc-go status errors into Connect errors so
+// browser clients see the same codes native gRPC clients do.
+type statusInterceptor struct{}
+
+func (statusInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		return resp, toConnectError(err)
+	}
+}
+
+func (statusInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (statusInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return toConnectError(next(ctx, conn))
+	}
+}
+
+func toConnectError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	if st, ok := status.FromError(err); ok {
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+	return err
+}
+
+// newWebHandler serves PokemonService and ItemService over gRPC-Web and
+// Connect, with CORS for the given browser origins ("*" allows any origin).
+func newWebHandler(server *pokemonServer, items *itemServer, limiter *rateLimiter, allowedOrigins []string) http.Handler {
+	interceptors := connect.WithInterceptors(statusInterceptor{}, limiter.connectInterceptor())
+	mux := http.NewServeMux()
+	mux.Handle(protoconnect.NewPokemonServiceHandler(connectServer{server}, interceptors))
+	mux.Handle(protoconnect.NewItemServiceHandler(items, interceptors))
+
+	return cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{
+			"Content-Type",
+			"Connect-Protocol-Version",
+			"Connect-Timeout-Ms",
+			"Grpc-Timeout",
+			"X-Grpc-Web",
+			"X-User-Agent",
+			"X-Api-Key",
+		},
+		ExposedHeaders: []string{
+			"Grpc-Status",
+			"Grpc-Message",
+			"Grpc-Status-Details-Bin",
+			"Retry-After",
+		},
+		MaxAge: 7200,
+	}).Handler(mux)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,106 @@
+// Package idempotency lets message consumers skip deliveries they've
+// already handled. With at-least-once delivery the same message can
+// arrive more than once, e.g. when the outbox relay republishes after a
+// lost confirm, or a consumer crashes before acking.
+//
+//	store, err := idempotency.NewStore(db, "order-notifications", 7*24*time.Hour)
+//	...
+//	duplicate, err := store.Process(d.MessageId, func(tx *gorm.DB) error {
+//		return sendConfirmationEmail(order)
+//	})
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const pruneInterval = time.Hour
+
+var ErrMissingID = errors.New("message has no ID")
+
+// ProcessedMessage records that a consumer handled a message.
+type ProcessedMessage struct {
+	Consumer    string    `gorm:"primaryKey;size:100"`
+	MessageID   string    `gorm:"primaryKey;size:64"`
+	ProcessedAt time.Time `gorm:"index"`
+}
+
+// Store keeps the IDs of messages one consumer has processed. IDs are
+// forgotten after the retention window, so it should be longer than a
+// message can spend in retry and dead-letter queues.
+type Store struct {
+	db        *gorm.DB
+	consumer  string
+	retention time.Duration
+}
+
+// NewStore creates the processed_messages table if needed. Each consumer
+// needs its own name, since they all have to see every message once.
+func NewStore(db *gorm.DB, consumer string, retention time.Duration) (*Store, error) {
+	if err := db.AutoMigrate(&ProcessedMessage{}); err != nil {
+		return nil, err
+	}
+	return &Store{db: db, consumer: consumer, retention: retention}, nil
+}
+
+// Process runs fn unless messageID was already processed, reporting
+// whether it was a duplicate. The ID is recorded in the same transaction
+// fn gets, so it's only kept if fn succeeds, and a concurrent delivery of
+// the same message waits for it and is then skipped. Work fn does outside
+// the database, like sending an email, can still repeat if the commit
+// fails after it.
+func (s *Store) Process(messageID string, fn func(tx *gorm.DB) error) (duplicate bool, err error) {
+	if messageID == "" {
+		return false, ErrMissingID
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&ProcessedMessage{
+			Consumer:    s.consumer,
+			MessageID:   messageID,
+			ProcessedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			duplicate = true
+			return nil
+		}
+		return fn(tx)
+	})
+	return duplicate, err
+}
+
+// Prune forgets IDs older than the retention window.
+func (s *Store) Prune() (int64, error) {
+	result := s.db.Where("consumer = ? AND processed_at < ?", s.consumer, time.Now().Add(-s.retention)).
+		Delete(&ProcessedMessage{})
+	return result.RowsAffected, result.Error
+}
+
+// Run prunes the store every hour until ctx is cancelled.
+func (s *Store) Run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := s.Prune(); err != nil {
+			log.Printf("Failed to prune processed messages: %v", err)
+		} else if n > 0 {
+			log.Printf("Pruned %d processed message IDs", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
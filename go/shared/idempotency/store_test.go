@@ -0,0 +1,118 @@
+package idempotency
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"shared/dbtest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+const insertSQL = `INSERT INTO "processed_messages" ("consumer","message_id","processed_at") VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`
+
+func newTestStore(t *testing.T) (*Store, sqlmock.Sqlmock) {
+	db, mock := dbtest.Mock(t)
+	return &Store{db: db, consumer: "order-notifications", retention: 24 * time.Hour}, mock
+}
+
+// timeNear matches a time argument within a second of want.
+type timeNear struct {
+	want time.Time
+}
+
+func (m timeNear) Match(v driver.Value) bool {
+	got, ok := v.(time.Time)
+	return ok && got.Sub(m.want).Abs() < time.Second
+}
+
+func TestProcess(t *testing.T) {
+	store, mock := newTestStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(insertSQL).
+		WithArgs("order-notifications", "msg-1", timeNear{time.Now()}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	called := false
+	duplicate, err := store.Process("msg-1", func(tx *gorm.DB) error {
+		called = true
+		return nil
+	})
+	if err != nil || duplicate || !called {
+		t.Errorf("Process() = %v, %v, called %v", duplicate, err, called)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessSkipsDuplicate(t *testing.T) {
+	store, mock := newTestStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(insertSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	duplicate, err := store.Process("msg-1", func(tx *gorm.DB) error {
+		t.Error("fn shouldn't run for a duplicate")
+		return nil
+	})
+	if err != nil || !duplicate {
+		t.Errorf("Process() = %v, %v, want a duplicate", duplicate, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessFailureIsNotRecorded(t *testing.T) {
+	store, mock := newTestStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(insertSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	errSend := errors.New("mail server unavailable")
+	duplicate, err := store.Process("msg-1", func(tx *gorm.DB) error {
+		return errSend
+	})
+	if !errors.Is(err, errSend) || duplicate {
+		t.Errorf("Process() = %v, %v, want %v", duplicate, err, errSend)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessMissingID(t *testing.T) {
+	store, mock := newTestStore(t)
+	_, err := store.Process("", func(tx *gorm.DB) error {
+		t.Error("fn shouldn't run without a message ID")
+		return nil
+	})
+	if !errors.Is(err, ErrMissingID) {
+		t.Errorf("Process() = %v, want ErrMissingID", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	store, mock := newTestStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "processed_messages" WHERE consumer = $1 AND processed_at < $2`).
+		WithArgs("order-notifications", timeNear{time.Now().Add(-24 * time.Hour)}).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	n, err := store.Prune()
+	if err != nil || n != 3 {
+		t.Errorf("Prune() = %d, %v, want 3", n, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,160 @@
+// Package pagination pages through gorm queries with keyset cursors. A
+// cursor encodes the sort it was made for and the sort value and ID of the
+// last row, so the next page starts after that row even while rows are
+// added or removed.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort field")
+)
+
+// SortKind is the kind of a sortable column, so cursor values can be
+// decoded back into something the column compares against.
+type SortKind int
+
+const (
+	SortInt SortKind = iota
+	SortFloat
+	SortString
+	SortTime
+)
+
+// cursor marks where a page ended: the sort it was made for, and the sort
+// value and ID of the last row.
+type cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+// parseSort splits a sort like "-created_at" into its column and
+// direction, checking the column is one of fields. Sorting defaults to ID.
+func parseSort(sort string, fields map[string]SortKind) (column string, desc bool, err error) {
+	column = strings.TrimPrefix(sort, "-")
+	if _, ok := fields[column]; !ok {
+		return "", false, fmt.Errorf("%w %q", ErrInvalidSort, column)
+	}
+	return column, strings.HasPrefix(sort, "-"), nil
+}
+
+// Paginate orders the query by column, then ID to break ties, and starts
+// it after the row encoded in after. It fetches one row more than limit,
+// so NextPage can tell whether there's another page.
+func Paginate(query *gorm.DB, sort, after string, limit int, fields map[string]SortKind) (*gorm.DB, error) {
+	sort = defaultSort(sort)
+	column, desc, err := parseSort(sort, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, compare := "ASC", ">"
+	if desc {
+		direction, compare = "DESC", "<"
+	}
+
+	if after != "" {
+		c, err := decodeCursor(after)
+		// A cursor only makes sense for the sort it came from
+		if err != nil || c.Sort != sort {
+			return nil, ErrInvalidCursor
+		}
+		if column == "id" {
+			query = query.Where("id "+compare+" ?", c.ID)
+		} else {
+			value, err := parseSortValue(fields[column], c.Value)
+			if err != nil {
+				return nil, ErrInvalidCursor
+			}
+			query = query.Where("("+column+", id) "+compare+" (?, ?)", value, c.ID)
+		}
+	}
+
+	if column != "id" {
+		query = query.Order(column + " " + direction)
+	}
+	return query.Order("id " + direction).Limit(limit + 1), nil
+}
+
+// NextPage drops the extra row Paginate fetched and returns the cursor
+// for the page after rows, or "" on the last page. key returns a row's
+// value for the sort column, and its ID.
+func NextPage[T any](rows []T, limit int, sort string, key func(row T, column string) (interface{}, uint)) ([]T, string) {
+	if len(rows) <= limit {
+		return rows, ""
+	}
+	rows = rows[:limit]
+
+	sort = defaultSort(sort)
+	value, id := key(rows[limit-1], strings.TrimPrefix(sort, "-"))
+	return rows, encodeCursor(cursor{Sort: sort, Value: formatSortValue(value), ID: id})
+}
+
+func defaultSort(sort string) string {
+	if sort == "" {
+		return "id"
+	}
+	return sort
+}
+
+// PageLimit clamps a requested page size.
+func PageLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultPageSize
+	}
+	return min(limit, MaxPageSize)
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+func formatSortValue(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+func parseSortValue(kind SortKind, s string) (interface{}, error) {
+	switch kind {
+	case SortInt:
+		return strconv.ParseInt(s, 10, 64)
+	case SortFloat:
+		return strconv.ParseFloat(s, 64)
+	case SortTime:
+		return time.Parse(time.RFC3339Nano, s)
+	}
+	return s, nil
+}
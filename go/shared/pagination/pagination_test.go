@@ -0,0 +1,155 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"shared/dbtest"
+
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID        uint
+	Name      string
+	Price     float64
+	CreatedAt time.Time
+}
+
+var itemSortFields = map[string]SortKind{
+	"id":         SortInt,
+	"name":       SortString,
+	"price":      SortFloat,
+	"created_at": SortTime,
+}
+
+func pageSQL(t *testing.T, sort, after string) (string, error) {
+	db := dbtest.DryRun(t)
+	var err error
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var query *gorm.DB
+		query, err = Paginate(tx.Model(&item{}), sort, after, 2, itemSortFields)
+		if err != nil {
+			return tx
+		}
+		return query.Find(&[]item{})
+	})
+	return sql, err
+}
+
+func itemKey(i item, column string) (interface{}, uint) {
+	switch column {
+	case "name":
+		return i.Name, i.ID
+	case "price":
+		return i.Price, i.ID
+	case "created_at":
+		return i.CreatedAt, i.ID
+	}
+	return i.ID, i.ID
+}
+
+func TestPaginate(t *testing.T) {
+	sql, err := pageSQL(t, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `SELECT * FROM "items" ORDER BY id ASC LIMIT 3`; sql != want {
+		t.Errorf("got %s, want %s", sql, want)
+	}
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	items := []item{
+		{ID: 9, Name: "Potion", Price: 300, CreatedAt: created.Add(time.Hour)},
+		{ID: 4, Name: "Antidote", Price: 99.95, CreatedAt: created},
+		{ID: 2},
+	}
+
+	// Each sort kind's cursor continues after the last row shown
+	tests := []struct {
+		sort string
+		want string
+	}{
+		{"-created_at", `SELECT * FROM "items" WHERE (created_at, id) < ('2024-05-01 12:00:00', 4) ORDER BY created_at DESC,id DESC LIMIT 3`},
+		{"price", `SELECT * FROM "items" WHERE (price, id) > (99.95, 4) ORDER BY price ASC,id ASC LIMIT 3`},
+		{"-name", `SELECT * FROM "items" WHERE (name, id) < ('Antidote', 4) ORDER BY name DESC,id DESC LIMIT 3`},
+		{"id", `SELECT * FROM "items" WHERE id > 4 ORDER BY id ASC LIMIT 3`},
+	}
+	for _, tt := range tests {
+		page, next := NextPage(items, 2, tt.sort, itemKey)
+		if len(page) != 2 || next == "" {
+			t.Fatalf("%s: got %d items and cursor %q", tt.sort, len(page), next)
+		}
+		sql, err := pageSQL(t, tt.sort, next)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.sort, err)
+		}
+		if sql != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.sort, sql, tt.want)
+		}
+	}
+
+	// The last page has no cursor
+	if _, next := NextPage(items[:2], 2, "", nil); next != "" {
+		t.Errorf("got cursor %q on the last page", next)
+	}
+}
+
+func TestPaginateErrors(t *testing.T) {
+	if _, err := pageSQL(t, "secret", ""); !errors.Is(err, ErrInvalidSort) {
+		t.Errorf("got %v, want ErrInvalidSort", err)
+	}
+	if _, err := pageSQL(t, "name", "not a cursor"); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("got %v, want ErrInvalidCursor", err)
+	}
+
+	// Cursors can't be reused with a different sort
+	after := encodeCursor(cursor{Sort: "name", Value: "Potion", ID: 3})
+	if _, err := pageSQL(t, "-name", after); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("got %v, want ErrInvalidCursor", err)
+	}
+
+	// Values have to parse as the column's kind
+	for sort, value := range map[string]string{"price": "cheap", "created_at": "yesterday", "-price": ""} {
+		after := encodeCursor(cursor{Sort: sort, Value: value, ID: 3})
+		if _, err := pageSQL(t, sort, after); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s cursor %q: got %v, want ErrInvalidCursor", sort, value, err)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 123456789, time.UTC)
+	tests := []struct {
+		kind  SortKind
+		value interface{}
+		want  interface{}
+	}{
+		{SortInt, uint(42), int64(42)},
+		{SortFloat, 0.1 + 0.2, 0.1 + 0.2},
+		{SortString, "paid", "paid"},
+		{SortTime, created, created},
+	}
+	for _, tt := range tests {
+		c, err := decodeCursor(encodeCursor(cursor{Sort: "s", Value: formatSortValue(tt.value), ID: 1}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := parseSortValue(tt.kind, c.Value)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.value, err)
+		}
+		if got != tt.want {
+			t.Errorf("got %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+		}
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	for limit, want := range map[int]int{0: DefaultPageSize, -1: DefaultPageSize, 5: 5, 500: MaxPageSize} {
+		if got := PageLimit(limit); got != want {
+			t.Errorf("PageLimit(%d) = %d, want %d", limit, got, want)
+		}
+	}
+}